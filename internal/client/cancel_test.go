@@ -0,0 +1,41 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRequestContextCancel(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Hang until the client gives up.
+		<-r.Context().Done()
+	}))
+	defer srv.Close()
+
+	c := New(context.Background(), "token", "quant", Options{Endpoint: srv.URL, MaxConcurrentRequests: 1})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, _, err := c.Instance.ProjectsAPI.ProjectsRead(c.RequestContext(ctx), "quant", "api-test").Execute()
+	assert.True(t, errors.Is(err, context.DeadlineExceeded), err)
+	assert.Less(t, time.Since(start), 5*time.Second)
+
+	// Requests queued behind a project write give up with their context too.
+	limit := c.Instance.GetConfig().HTTPClient.Transport.(*requestIDTransport).base.(*retryTransport).base.(*limitTransport)
+	lock := limit.projectLock("/organizations/quant/projects/api-test")
+	lock <- struct{}{}
+	defer func() { <-lock }()
+
+	ctx, cancel = context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, err = c.RulesDelete(c.RequestContext(ctx), "quant", "api-test", "auth", "uuid")
+	assert.True(t, errors.Is(err, context.DeadlineExceeded), err)
+}
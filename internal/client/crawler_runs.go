@@ -0,0 +1,72 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// CrawlerRun is a single execution of a crawler.
+type CrawlerRun struct {
+	Id              int64  `json:"id"`
+	ProjectId       int64  `json:"project_id,omitempty"`
+	CrawlerConfigId int64  `json:"crawler_config_id,omitempty"`
+	Status          string `json:"status"`
+	UrlsTotal       int64  `json:"urls_total,omitempty"`
+	UrlsCrawled     int64  `json:"urls_crawled,omitempty"`
+	UrlsFailed      int64  `json:"urls_failed,omitempty"`
+	StartedAt       string `json:"started_at,omitempty"`
+	CompletedAt     string `json:"completed_at,omitempty"`
+	CreatedAt       string `json:"created_at,omitempty"`
+	UpdatedAt       string `json:"updated_at,omitempty"`
+}
+
+// Succeeded reports whether the run finished without error.
+func (r *CrawlerRun) Succeeded() bool {
+	switch strings.ToLower(r.Status) {
+	case "completed", "complete", "finished", "success", "succeeded":
+		return true
+	}
+	return false
+}
+
+// Failed reports whether the run finished with an error.
+func (r *CrawlerRun) Failed() bool {
+	switch strings.ToLower(r.Status) {
+	case "failed", "failure", "error", "errored", "cancelled", "canceled":
+		return true
+	}
+	return false
+}
+
+func crawlerRunsPath(organization string, project string, crawler string) string {
+	return fmt.Sprintf(
+		"/organizations/%s/projects/%s/crawlers/%s",
+		url.PathEscape(organization),
+		url.PathEscape(project),
+		url.PathEscape(crawler),
+	)
+}
+
+// CrawlersRun starts a new run of a crawler.
+func (c *Client) CrawlersRun(ctx context.Context, organization string, project string, crawler string) (*CrawlerRun, *http.Response, error) {
+	var run CrawlerRun
+	res, err := c.Do(ctx, http.MethodPost, crawlerRunsPath(organization, project, crawler)+"/run", nil, &run)
+	return &run, res, err
+}
+
+// CrawlerRunsList lists the runs of a crawler.
+func (c *Client) CrawlerRunsList(ctx context.Context, organization string, project string, crawler string) ([]CrawlerRun, *http.Response, error) {
+	var runs []CrawlerRun
+	res, err := c.Do(ctx, http.MethodGet, crawlerRunsPath(organization, project, crawler)+"/runs", nil, &runs)
+	return runs, res, err
+}
+
+// CrawlerRunsRead loads a single crawler run.
+func (c *Client) CrawlerRunsRead(ctx context.Context, organization string, project string, crawler string, id int64) (*CrawlerRun, *http.Response, error) {
+	var run CrawlerRun
+	res, err := c.Do(ctx, http.MethodGet, fmt.Sprintf("%s/runs/%d", crawlerRunsPath(organization, project, crawler), id), nil, &run)
+	return &run, res, err
+}
@@ -0,0 +1,68 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// CrawlerSchedule is a recurring run of a crawler.
+type CrawlerSchedule struct {
+	Id                 int64  `json:"id,omitempty"`
+	Name               string `json:"name,omitempty"`
+	ProjectId          int64  `json:"project_id,omitempty"`
+	CrawlerConfigId    int64  `json:"crawler_config_id,omitempty"`
+	CrawlerLastRunId   int64  `json:"crawler_last_run_id,omitempty"`
+	ScheduleCronString string `json:"schedule_cron_string"`
+	CreatedAt          string `json:"created_at,omitempty"`
+	UpdatedAt          string `json:"updated_at,omitempty"`
+}
+
+// CrawlerScheduleRequest is the payload to create or update a schedule.
+type CrawlerScheduleRequest struct {
+	Name               string `json:"name,omitempty"`
+	ScheduleCronString string `json:"schedule_cron_string"`
+}
+
+func crawlerSchedulesPath(organization string, project string, crawler string) string {
+	return fmt.Sprintf(
+		"/organizations/%s/projects/%s/crawlers/%s/schedules",
+		url.PathEscape(organization),
+		url.PathEscape(project),
+		url.PathEscape(crawler),
+	)
+}
+
+// CrawlerSchedulesList lists the schedules for a crawler.
+func (c *Client) CrawlerSchedulesList(ctx context.Context, organization string, project string, crawler string) ([]CrawlerSchedule, *http.Response, error) {
+	var schedules []CrawlerSchedule
+	res, err := c.Do(ctx, http.MethodGet, crawlerSchedulesPath(organization, project, crawler), nil, &schedules)
+	return schedules, res, err
+}
+
+// CrawlerSchedulesCreate adds a schedule to a crawler.
+func (c *Client) CrawlerSchedulesCreate(ctx context.Context, organization string, project string, crawler string, req CrawlerScheduleRequest) (*CrawlerSchedule, *http.Response, error) {
+	var schedule CrawlerSchedule
+	res, err := c.Do(ctx, http.MethodPost, crawlerSchedulesPath(organization, project, crawler), req, &schedule)
+	return &schedule, res, err
+}
+
+// CrawlerSchedulesRead loads a single crawler schedule.
+func (c *Client) CrawlerSchedulesRead(ctx context.Context, organization string, project string, crawler string, id int64) (*CrawlerSchedule, *http.Response, error) {
+	var schedule CrawlerSchedule
+	res, err := c.Do(ctx, http.MethodGet, fmt.Sprintf("%s/%d", crawlerSchedulesPath(organization, project, crawler), id), nil, &schedule)
+	return &schedule, res, err
+}
+
+// CrawlerSchedulesUpdate changes the name or cadence of a schedule.
+func (c *Client) CrawlerSchedulesUpdate(ctx context.Context, organization string, project string, crawler string, id int64, req CrawlerScheduleRequest) (*CrawlerSchedule, *http.Response, error) {
+	var schedule CrawlerSchedule
+	res, err := c.Do(ctx, http.MethodPatch, fmt.Sprintf("%s/%d", crawlerSchedulesPath(organization, project, crawler), id), req, &schedule)
+	return &schedule, res, err
+}
+
+// CrawlerSchedulesDelete removes a schedule from a crawler.
+func (c *Client) CrawlerSchedulesDelete(ctx context.Context, organization string, project string, crawler string, id int64) (*http.Response, error) {
+	return c.Do(ctx, http.MethodDelete, fmt.Sprintf("%s/%d", crawlerSchedulesPath(organization, project, crawler), id), nil, nil)
+}
@@ -0,0 +1,119 @@
+package client
+
+import (
+	"io"
+	"net/http"
+	"regexp"
+	"sync"
+)
+
+// DefaultMaxConcurrentRequests is used when the provider does not set
+// max_concurrent_requests.
+const DefaultMaxConcurrentRequests = 5
+
+var projectPath = regexp.MustCompile(`/organizations/[^/]+/projects/[^/]+`)
+
+// limitTransport caps the number of requests in flight across the provider
+// and lets only one write per project through at a time. Several project
+// settings, such as custom headers and rules, are stored as a single document
+// by the API and concurrent writes overwrite each other.
+type limitTransport struct {
+	base http.RoundTripper
+	sem  chan struct{}
+
+	// Per project locks, a channel with room for one holder so that
+	// waiting for a lock can be cancelled.
+	mu       sync.Mutex
+	projects map[string]chan struct{}
+}
+
+func newLimitTransport(base http.RoundTripper, maxConcurrent int) *limitTransport {
+	t := &limitTransport{base: base, projects: map[string]chan struct{}{}}
+	if maxConcurrent > 0 {
+		t.sem = make(chan struct{}, maxConcurrent)
+	}
+	return t
+}
+
+func (t *limitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	var unlock func()
+
+	// Take the project lock before a request slot so queued writers do not
+	// hold slots other projects could use.
+	if isWrite(req.Method) {
+		if project := projectPath.FindString(req.URL.Path); project != "" {
+			lock := t.projectLock(project)
+			select {
+			case lock <- struct{}{}:
+				unlock = func() { <-lock }
+			case <-req.Context().Done():
+				return nil, req.Context().Err()
+			}
+		}
+	}
+
+	if t.sem != nil {
+		select {
+		case t.sem <- struct{}{}:
+		case <-req.Context().Done():
+			if unlock != nil {
+				unlock()
+			}
+			return nil, req.Context().Err()
+		}
+	}
+
+	var once sync.Once
+	release := func() {
+		once.Do(func() {
+			if t.sem != nil {
+				<-t.sem
+			}
+			if unlock != nil {
+				unlock()
+			}
+		})
+	}
+
+	res, err := t.base.RoundTrip(req)
+	if err != nil || res == nil || res.Body == nil {
+		release()
+		return res, err
+	}
+
+	// Hold the slot until the response has been read.
+	res.Body = &releaseBody{ReadCloser: res.Body, release: release}
+	return res, nil
+}
+
+func (t *limitTransport) projectLock(project string) chan struct{} {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	lock, ok := t.projects[project]
+	if !ok {
+		lock = make(chan struct{}, 1)
+		t.projects[project] = lock
+	}
+	return lock
+}
+
+func isWrite(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return false
+	}
+	return true
+}
+
+// releaseBody gives the request slot back once the body is closed.
+type releaseBody struct {
+	io.ReadCloser
+	release func()
+}
+
+func (b *releaseBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.release()
+	return err
+}
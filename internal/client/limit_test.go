@@ -0,0 +1,104 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// inflight counts concurrent requests per group and remembers the peak.
+type inflight struct {
+	mu    sync.Mutex
+	count map[string]int
+	peak  map[string]int
+}
+
+func (f *inflight) enter(group string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.count[group]++
+	if f.count[group] > f.peak[group] {
+		f.peak[group] = f.count[group]
+	}
+}
+
+func (f *inflight) leave(group string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.count[group]--
+}
+
+func TestLimitTransport(t *testing.T) {
+	f := &inflight{count: map[string]int{}, peak: map[string]int{}}
+	var total int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&total, 1)
+		f.enter("all")
+		f.enter(r.Method + " " + r.URL.Path)
+		time.Sleep(20 * time.Millisecond)
+		f.leave(r.Method + " " + r.URL.Path)
+		f.leave("all")
+	}))
+	defer srv.Close()
+
+	c := New(context.Background(), "token", "quant", Options{Endpoint: srv.URL, MaxConcurrentRequests: 3})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 6; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			c.Do(context.Background(), http.MethodPost, "/organizations/quant/projects/a/custom-headers", map[string]string{}, nil)
+		}()
+		go func() {
+			defer wg.Done()
+			c.Do(context.Background(), http.MethodGet, "/organizations/quant/projects/b/rules/auth", nil, nil)
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, int32(12), total)
+	assert.LessOrEqual(t, f.peak["all"], 3)
+	assert.Equal(t, 1, f.peak["POST /organizations/quant/projects/a/custom-headers"], "writes to one project are serialised")
+	assert.Greater(t, f.peak["GET /organizations/quant/projects/b/rules/auth"], 1, "reads are not serialised")
+}
+
+func TestLimitTransportRetryBackoff(t *testing.T) {
+	var throttled int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/organizations/quant/projects/a/custom-headers" && atomic.AddInt32(&throttled, 1) == 1 {
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusTooManyRequests)
+		}
+	}))
+	defer srv.Close()
+
+	c := New(context.Background(), "token", "quant", Options{Endpoint: srv.URL, MaxConcurrentRequests: 1, MaxRetries: 1})
+
+	done := make(chan error, 1)
+	go func() {
+		_, err := c.Do(context.Background(), http.MethodPost, "/organizations/quant/projects/a/custom-headers", map[string]string{}, nil)
+		done <- err
+	}()
+
+	// Wait for the write to be throttled, its backoff must not keep the
+	// only request slot or the project's write lock.
+	for atomic.LoadInt32(&throttled) == 0 {
+		time.Sleep(time.Millisecond)
+	}
+	time.Sleep(20 * time.Millisecond)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 500*time.Millisecond)
+	defer cancel()
+	_, err := c.Do(ctx, http.MethodPost, "/organizations/quant/projects/a/rules/auth", map[string]string{}, nil)
+	assert.Nil(t, err)
+
+	assert.Nil(t, <-done)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&throttled))
+}
@@ -0,0 +1,130 @@
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const redacted = "***"
+
+// Keys whose values are never written to the logs, matched case
+// insensitively anywhere in a request or response body.
+var sensitiveKeys = map[string]bool{
+	"auth_pass":                 true,
+	"basic_auth_password":       true,
+	"api_key":                   true,
+	"custom_s3_sync_secret_key": true,
+	"custom_s3_sync_access_key": true,
+	"password":                  true,
+	"token":                     true,
+	"secret":                    true,
+}
+
+var slackWebhook = regexp.MustCompile(`https://hooks\.slack\.com/[^\s"']+`)
+
+// logTransport writes a debug entry for every API request and response, the
+// entries are visible with TF_LOG=debug.
+type logTransport struct {
+	base http.RoundTripper
+}
+
+func (t *logTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+
+	fields := map[string]interface{}{
+		"method": req.Method,
+		"path":   req.URL.Path,
+	}
+	if req.URL.RawQuery != "" {
+		fields["query"] = req.URL.RawQuery
+	}
+	if id := req.Header.Get(RequestIDHeader); id != "" {
+		fields["request_id"] = id
+	}
+	if req.Body != nil && req.GetBody != nil {
+		if body, err := req.GetBody(); err == nil {
+			b, _ := io.ReadAll(body)
+			body.Close()
+			if len(b) > 0 {
+				fields["request_body"] = redactBody(b)
+			}
+		}
+	}
+
+	tflog.Debug(ctx, "QuantCDN API request", fields)
+
+	start := time.Now()
+	res, err := t.base.RoundTrip(req)
+	fields["latency_ms"] = time.Since(start).Milliseconds()
+
+	if err != nil {
+		fields["error"] = err.Error()
+		tflog.Debug(ctx, "QuantCDN API request failed", fields)
+		return res, err
+	}
+
+	fields["status"] = res.StatusCode
+	if id := res.Header.Get(RequestIDHeader); id != "" {
+		fields["request_id"] = id
+	}
+
+	if res.Body != nil {
+		b, rerr := io.ReadAll(res.Body)
+		res.Body.Close()
+		res.Body = io.NopCloser(bytes.NewReader(b))
+		if rerr == nil && len(b) > 0 {
+			fields["response_body"] = redactBody(b)
+		}
+	}
+
+	delete(fields, "request_body")
+	tflog.Debug(ctx, "QuantCDN API response", fields)
+
+	return res, nil
+}
+
+// redactBody masks secrets in a JSON body before it is logged. Bodies that
+// are not JSON only have webhook URLs masked.
+func redactBody(b []byte) string {
+	var v interface{}
+	if err := json.Unmarshal(b, &v); err != nil {
+		return slackWebhook.ReplaceAllString(string(b), redacted)
+	}
+
+	out, err := json.Marshal(redact(v))
+	if err != nil {
+		return redacted
+	}
+	return string(out)
+}
+
+func redact(v interface{}) interface{} {
+	switch value := v.(type) {
+	case map[string]interface{}:
+		for k, item := range value {
+			if sensitiveKeys[strings.ToLower(k)] || strings.Contains(strings.ToLower(k), "webhook") {
+				if item != nil && item != "" {
+					value[k] = redacted
+				}
+				continue
+			}
+			value[k] = redact(item)
+		}
+		return value
+	case []interface{}:
+		for i, item := range value {
+			value[i] = redact(item)
+		}
+		return value
+	case string:
+		return slackWebhook.ReplaceAllString(value, redacted)
+	}
+	return v
+}
@@ -0,0 +1,30 @@
+package client
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRedactBody(t *testing.T) {
+	body := `{
+		"name": "staging",
+		"auth_user": "admin",
+		"auth_pass": "hunter2",
+		"basic_auth_password": "letmein",
+		"action_config": {
+			"waf_config": {"httpbl": {"httpbl_enabled": true, "api_key": "abc123"}},
+			"notify_config": {"slack_webhook": "https://hooks.slack.com/services/T0/B0/xyz"}
+		},
+		"notes": ["see https://hooks.slack.com/services/T1/B1/abc"]
+	}`
+
+	out := redactBody([]byte(body))
+	for _, secret := range []string{"hunter2", "letmein", "abc123", "hooks.slack.com"} {
+		assert.NotContains(t, out, secret)
+	}
+	assert.Contains(t, out, `"auth_user":"admin"`)
+	assert.Contains(t, out, `"httpbl_enabled":true`)
+
+	assert.Equal(t, "plain ***", redactBody([]byte("plain https://hooks.slack.com/services/T0/B0/xyz")))
+}
@@ -2,7 +2,14 @@ package client
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	openapi "github.com/quantcdn/quant-admin-go"
 )
 
@@ -11,20 +18,129 @@ type Client struct {
 	Bearer       string
 	Organization string
 	Instance     *openapi.APIClient
+
+	// Project is the project used by resources that do not set one, it
+	// is empty when the provider has no default project.
+	Project string
+}
+
+// Options holds the optional provider settings that change how the client
+// talks to the API.
+type Options struct {
+	// Endpoint replaces the default Admin API server URL, eg. to point the
+	// provider at a staging environment or a local mock server.
+	Endpoint string
+
+	// MaxRetries is how many times a throttled or failed request is
+	// retried, zero disables retries.
+	MaxRetries int
+
+	// RetryMaxWait caps the wait between two attempts.
+	RetryMaxWait time.Duration
+
+	// MaxConcurrentRequests caps the requests in flight across all
+	// resources, zero means no limit.
+	MaxConcurrentRequests int
+
+	// Project is the default project for resources that do not set one.
+	Project string
+
+	// Proxy is the HTTP proxy requests are sent through, credentials may be
+	// given in its user info. HTTPS_PROXY and NO_PROXY apply when it is nil.
+	Proxy *url.URL
+
+	// RootCAs holds PEM encoded certificates trusted in addition to the
+	// system roots, eg. the CA of a TLS intercepting proxy.
+	RootCAs []byte
+
+	// Certificates are presented to the server for mutual TLS.
+	Certificates []tls.Certificate
+
+	// UserAgent replaces the User-Agent of quant-admin-go.
+	UserAgent string
+
+	// InsecureSkipVerify disables server certificate verification, it is
+	// only meant for local stand-ins of the API.
+	InsecureSkipVerify bool
 }
 
 // Rather than the practioner providing an organization for all resources
 // managed by the terraform instance we scope the data client to an organization
-// with provider configuration.
-func New(bearer string, organization string) *Client {
+// with provider configuration. Requests are logged with the logger carried
+// by ctx, normally the provider's Configure context.
+func New(ctx context.Context, bearer string, organization string, opts Options) *Client {
 	cfg := openapi.NewConfiguration()
+	if opts.Endpoint != "" {
+		cfg.Servers = openapi.ServerConfigurations{
+			{URL: strings.TrimSuffix(opts.Endpoint, "/")},
+		}
+	}
+
+	if opts.UserAgent != "" {
+		cfg.UserAgent = opts.UserAgent
+	}
+
+	// The limit is taken around each attempt rather than the whole retry
+	// loop, so that requests waiting out a backoff do not hold a request
+	// slot or the project's write lock.
+	cfg.HTTPClient = &http.Client{
+		Transport: &requestIDTransport{
+			base: newRetryTransport(
+				newLimitTransport(&logTransport{base: newBaseTransport(opts)}, opts.MaxConcurrentRequests),
+				opts.MaxRetries,
+				opts.RetryMaxWait,
+			),
+		},
+	}
+
 	client := openapi.NewAPIClient(cfg)
-	ctx := context.WithValue(context.Background(), openapi.ContextAccessToken, bearer)
+
+	// The Configure context is cancelled once configuration finishes, keep
+	// its values but not its deadline for requests made later on.
+	ctx = tflog.MaskAllFieldValuesStrings(context.WithoutCancel(ctx), bearer)
+	ctx = context.WithValue(ctx, openapi.ContextAccessToken, bearer)
 
 	return &Client{
 		Bearer:       bearer,
 		AuthContext:  ctx,
 		Instance:     client,
 		Organization: organization,
+		Project:      opts.Project,
 	}
 }
+
+// RequestContext returns ctx with the credentials of AuthContext, and the
+// bearer token masked in its logs. Requests made with it are cancelled with
+// ctx, eg. when an operation times out or Terraform is interrupted.
+func (c *Client) RequestContext(ctx context.Context) context.Context {
+	ctx = tflog.MaskAllFieldValuesStrings(ctx, c.Bearer)
+	return context.WithValue(ctx, openapi.ContextAccessToken, c.Bearer)
+}
+
+// newBaseTransport returns the transport that sends requests over the wire,
+// http.DefaultTransport with the proxy and TLS options applied.
+func newBaseTransport(opts Options) http.RoundTripper {
+	if opts.Proxy == nil && len(opts.RootCAs) == 0 && len(opts.Certificates) == 0 && !opts.InsecureSkipVerify {
+		return http.DefaultTransport
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if opts.Proxy != nil {
+		transport.Proxy = http.ProxyURL(opts.Proxy)
+	}
+
+	transport.TLSClientConfig = &tls.Config{
+		Certificates:       opts.Certificates,
+		InsecureSkipVerify: opts.InsecureSkipVerify,
+	}
+	if len(opts.RootCAs) > 0 {
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		pool.AppendCertsFromPEM(opts.RootCAs)
+		transport.TLSClientConfig.RootCAs = pool
+	}
+
+	return transport
+}
@@ -0,0 +1,85 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// APIError is returned by Do when the API responds with an error status.
+type APIError struct {
+	StatusCode int
+	Status     string
+	Body       []byte
+}
+
+func (e *APIError) Error() string {
+	return strings.TrimSpace(fmt.Sprintf("%s %s", e.Status, string(e.Body)))
+}
+
+// Do sends a JSON request for Admin API endpoints that quant-admin-go does
+// not cover yet. It shares the configuration, HTTP client and credentials
+// of Instance so both paths behave the same. The response body is decoded
+// into out when it is not nil.
+func (c *Client) Do(ctx context.Context, method string, endpoint string, body interface{}, out interface{}) (*http.Response, error) {
+	cfg := c.Instance.GetConfig()
+
+	base, err := cfg.ServerURLWithContext(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+
+	var reader io.Reader
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return nil, err
+		}
+		reader = bytes.NewReader(b)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, strings.TrimSuffix(base, "/")+endpoint, reader)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	for k, v := range cfg.DefaultHeader {
+		req.Header.Set(k, v)
+	}
+	if cfg.UserAgent != "" {
+		req.Header.Set("User-Agent", cfg.UserAgent)
+	}
+	req.Header.Set("Authorization", "Bearer "+c.Bearer)
+
+	res, err := cfg.HTTPClient.Do(req)
+	if err != nil {
+		return res, err
+	}
+
+	b, err := io.ReadAll(res.Body)
+	res.Body.Close()
+	if err != nil {
+		return res, err
+	}
+	res.Body = io.NopCloser(bytes.NewBuffer(b))
+
+	if res.StatusCode >= 300 {
+		return res, &APIError{StatusCode: res.StatusCode, Status: res.Status, Body: b}
+	}
+
+	if out != nil && len(b) > 0 {
+		if err := json.Unmarshal(b, out); err != nil {
+			return res, err
+		}
+	}
+
+	return res, nil
+}
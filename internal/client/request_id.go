@@ -0,0 +1,58 @@
+package client
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"net/http"
+)
+
+// RequestIDHeader carries the ID that ties API requests to a Terraform
+// operation, quote it when raising a support ticket with QuantCDN.
+const RequestIDHeader = "X-Request-ID"
+
+type requestIDKey struct{}
+
+// NewRequestID returns a random version 4 UUID.
+func NewRequestID() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	b[6] = b[6]&0x0f | 0x40
+	b[8] = b[8]&0x3f | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:])
+}
+
+// WithRequestID returns ctx carrying id, every request made with the context
+// is sent with it.
+func WithRequestID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, requestIDKey{}, id)
+}
+
+// RequestID returns the request ID carried by ctx, if any.
+func RequestID(ctx context.Context) string {
+	id, _ := ctx.Value(requestIDKey{}).(string)
+	return id
+}
+
+// requestIDTransport sends the request ID of the request context, or a new
+// one for requests made outside of an operation.
+type requestIDTransport struct {
+	base http.RoundTripper
+}
+
+func (t *requestIDTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Header.Get(RequestIDHeader) != "" {
+		return t.base.RoundTrip(req)
+	}
+
+	id := RequestID(req.Context())
+	if id == "" {
+		id = NewRequestID()
+	}
+
+	// A RoundTripper must not modify the request it was given.
+	req = req.Clone(req.Context())
+	req.Header.Set(RequestIDHeader, id)
+
+	return t.base.RoundTrip(req)
+}
@@ -0,0 +1,36 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRequestID(t *testing.T) {
+	var ids, agents []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ids = append(ids, r.Header.Get(RequestIDHeader))
+		agents = append(agents, r.Header.Get("User-Agent"))
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{}`))
+	}))
+	defer srv.Close()
+
+	c := New(context.Background(), "token", "quant", Options{Endpoint: srv.URL, UserAgent: "terraform-provider-quant/test terraform/1.9.5"})
+
+	ctx := c.RequestContext(WithRequestID(context.Background(), "operation-1"))
+	_, _, _ = c.Instance.ProjectsAPI.ProjectsRead(ctx, "quant", "api-test").Execute()
+	_, _ = c.Do(WithRequestID(context.Background(), "operation-1"), http.MethodGet, "/organizations/quant", nil, nil)
+	_, _ = c.Do(context.Background(), http.MethodGet, "/organizations/quant", nil, nil)
+
+	assert.Len(t, ids, 3)
+	assert.Equal(t, "operation-1", ids[0])
+	assert.Equal(t, "operation-1", ids[1])
+	assert.Regexp(t, `^[0-9a-f]{8}-[0-9a-f]{4}-4[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$`, ids[2])
+	for _, ua := range agents {
+		assert.Equal(t, "terraform-provider-quant/test terraform/1.9.5", ua)
+	}
+}
@@ -0,0 +1,154 @@
+package client
+
+import (
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	// DefaultMaxRetries is used when the provider does not set max_retries.
+	DefaultMaxRetries = 3
+	// DefaultRetryMaxWait is used when the provider does not set retry_max_wait.
+	DefaultRetryMaxWait = 30 * time.Second
+)
+
+// First backoff interval, doubled on each retry up to the maximum wait.
+var retryMinWait = 1 * time.Second
+
+// retryTransport retries requests the API throttled or could not serve with
+// a bounded exponential backoff. Retry-After is honoured when it is sent.
+type retryTransport struct {
+	base       http.RoundTripper
+	maxRetries int
+	maxWait    time.Duration
+}
+
+func newRetryTransport(base http.RoundTripper, maxRetries int, maxWait time.Duration) *retryTransport {
+	if maxRetries < 0 {
+		maxRetries = 0
+	}
+	if maxWait <= 0 {
+		maxWait = DefaultRetryMaxWait
+	}
+	return &retryTransport{base: base, maxRetries: maxRetries, maxWait: maxWait}
+}
+
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	for attempt := 0; ; attempt++ {
+		res, err := t.base.RoundTrip(req)
+
+		if attempt >= t.maxRetries || !shouldRetry(req, res, err) {
+			return res, err
+		}
+
+		// The body has already been sent, it can only be replayed when the
+		// request knows how to rebuild it.
+		if req.Body != nil && req.Body != http.NoBody {
+			if req.GetBody == nil {
+				return res, err
+			}
+			body, berr := req.GetBody()
+			if berr != nil {
+				return res, err
+			}
+			req = req.Clone(req.Context())
+			req.Body = body
+		}
+
+		wait := t.backoff(attempt, res)
+		if res != nil {
+			res.Body.Close()
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// shouldRetry reports whether a failed attempt is worth repeating. Throttling
+// and gateway errors mean the request was not processed so any method can be
+// retried, connection errors and internal server errors are only retried for
+// idempotent methods.
+func shouldRetry(req *http.Request, res *http.Response, err error) bool {
+	if err != nil {
+		if req.Context().Err() != nil {
+			return false
+		}
+		return idempotent(req.Method)
+	}
+
+	switch res.StatusCode {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	case http.StatusInternalServerError:
+		return idempotent(req.Method)
+	}
+	return false
+}
+
+// idempotent reports whether repeating a request with method has the same
+// effect as sending it once.
+func idempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// backoff returns how long to wait before the next attempt.
+func (t *retryTransport) backoff(attempt int, res *http.Response) time.Duration {
+	if wait, ok := retryAfter(res); ok {
+		if wait > t.maxWait {
+			return t.maxWait
+		}
+		return wait
+	}
+
+	wait := retryMinWait << uint(attempt)
+	if wait <= 0 || wait > t.maxWait {
+		wait = t.maxWait
+	}
+
+	// Jitter between half and the full interval so parallel operations do
+	// not retry in lockstep.
+	half := int64(wait / 2)
+	if half <= 0 {
+		return wait
+	}
+	return time.Duration(half + rand.Int63n(half+1))
+}
+
+// retryAfter parses the Retry-After header in either its seconds or HTTP
+// date form.
+func retryAfter(res *http.Response) (time.Duration, bool) {
+	if res == nil {
+		return 0, false
+	}
+
+	v := res.Header.Get("Retry-After")
+	if v == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(v); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if at, err := http.ParseTime(v); err == nil {
+		wait := time.Until(at)
+		if wait < 0 {
+			wait = 0
+		}
+		return wait, true
+	}
+
+	return 0, false
+}
@@ -0,0 +1,106 @@
+package client
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRetryTransport(t *testing.T) {
+	retryMinWait = time.Millisecond
+
+	var attempts int
+	var bodies []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		b, _ := io.ReadAll(r.Body)
+		bodies = append(bodies, string(b))
+		switch attempts {
+		case 1:
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+		case 2:
+			w.WriteHeader(http.StatusServiceUnavailable)
+		default:
+			w.Write([]byte(`{"uuid":"ok"}`))
+		}
+	}))
+	defer srv.Close()
+
+	c := New(context.Background(), "token", "quant", Options{Endpoint: srv.URL, MaxRetries: 3, RetryMaxWait: time.Second})
+
+	var out Rule
+	_, err := c.Do(context.Background(), http.MethodPost, "/rules", map[string]string{"name": "retry"}, &out)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, attempts)
+	assert.Equal(t, "ok", out.Uuid)
+	for _, b := range bodies {
+		assert.True(t, strings.Contains(b, `"retry"`), "request body is replayed on retry")
+	}
+}
+
+func TestRetryTransportServerError(t *testing.T) {
+	retryMinWait = time.Millisecond
+
+	var attempts int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts == 1 {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.Write([]byte(`{"uuid":"ok"}`))
+	}))
+	defer srv.Close()
+
+	c := New(context.Background(), "token", "quant", Options{Endpoint: srv.URL, MaxRetries: 3, RetryMaxWait: time.Second})
+
+	_, err := c.Do(context.Background(), http.MethodGet, "/rules/ok", nil, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, attempts)
+
+	// A create may have been processed before the server failed.
+	attempts = 0
+	res, err := c.Do(context.Background(), http.MethodPost, "/rules", map[string]string{"name": "retry"}, nil)
+	assert.NotNil(t, err)
+	assert.Equal(t, http.StatusInternalServerError, res.StatusCode)
+	assert.Equal(t, 1, attempts)
+}
+
+func TestRetryTransportGivesUp(t *testing.T) {
+	retryMinWait = time.Millisecond
+
+	var attempts int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer srv.Close()
+
+	c := New(context.Background(), "token", "quant", Options{Endpoint: srv.URL, MaxRetries: 2, RetryMaxWait: time.Second})
+
+	res, err := c.Do(context.Background(), http.MethodGet, "/rules", nil, nil)
+	assert.NotNil(t, err)
+	assert.Equal(t, http.StatusTooManyRequests, res.StatusCode)
+	assert.Equal(t, 3, attempts)
+}
+
+func TestRetryAfter(t *testing.T) {
+	tr := newRetryTransport(http.DefaultTransport, 3, 10*time.Second)
+
+	res := &http.Response{Header: http.Header{}}
+	res.Header.Set("Retry-After", "4")
+	assert.Equal(t, 4*time.Second, tr.backoff(0, res))
+
+	res.Header.Set("Retry-After", "120")
+	assert.Equal(t, 10*time.Second, tr.backoff(0, res), "Retry-After is capped at the maximum wait")
+
+	res.Header.Set("Retry-After", time.Now().Add(-time.Minute).UTC().Format(http.TimeFormat))
+	assert.Equal(t, time.Duration(0), tr.backoff(0, res))
+}
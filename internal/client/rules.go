@@ -0,0 +1,167 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// Rule is the shape shared by every rule type. Fields specific to a rule
+// type are returned in ActionConfig and decoded by the caller.
+type Rule struct {
+	Name           string          `json:"name,omitempty"`
+	Uuid           string          `json:"uuid"`
+	RuleId         string          `json:"rule_id,omitempty"`
+	Url            []string        `json:"url,omitempty"`
+	Domain         []string        `json:"domain,omitempty"`
+	Disabled       bool            `json:"disabled"`
+	OnlyWithCookie interface{}     `json:"only_with_cookie,omitempty"`
+	Method         string          `json:"method,omitempty"`
+	MethodIs       []string        `json:"method_is,omitempty"`
+	MethodIsNot    []string        `json:"method_is_not,omitempty"`
+	Ip             string          `json:"ip,omitempty"`
+	IpIs           []string        `json:"ip_is,omitempty"`
+	IpIsNot        []string        `json:"ip_is_not,omitempty"`
+	Country        string          `json:"country,omitempty"`
+	CountryIs      []string        `json:"country_is,omitempty"`
+	CountryIsNot   []string        `json:"country_is_not,omitempty"`
+	Action         string          `json:"action"`
+	ActionConfig   json.RawMessage `json:"action_config,omitempty"`
+}
+
+func (o *Rule) GetName() string           { return o.Name }
+func (o *Rule) GetUuid() string           { return o.Uuid }
+func (o *Rule) GetRuleId() string         { return o.RuleId }
+func (o *Rule) GetUrl() []string          { return o.Url }
+func (o *Rule) GetDomain() []string       { return o.Domain }
+func (o *Rule) GetDisabled() bool         { return o.Disabled }
+func (o *Rule) GetMethod() string         { return o.Method }
+func (o *Rule) GetMethodIs() []string     { return o.MethodIs }
+func (o *Rule) GetMethodIsNot() []string  { return o.MethodIsNot }
+func (o *Rule) GetIp() string             { return o.Ip }
+func (o *Rule) GetIpIs() []string         { return o.IpIs }
+func (o *Rule) GetIpIsNot() []string      { return o.IpIsNot }
+func (o *Rule) GetCountry() string        { return o.Country }
+func (o *Rule) GetCountryIs() []string    { return o.CountryIs }
+func (o *Rule) GetCountryIsNot() []string { return o.CountryIsNot }
+
+// GetOnlyWithCookie returns only_with_cookie as a string, the API sends
+// either a boolean or a string depending on the rule type.
+func (o *Rule) GetOnlyWithCookie() string {
+	switch v := o.OnlyWithCookie.(type) {
+	case bool:
+		return strconv.FormatBool(v)
+	case string:
+		return v
+	}
+	return ""
+}
+
+// DecodeActionConfig unmarshals the rule type specific configuration into v.
+func (o *Rule) DecodeActionConfig(v interface{}) error {
+	if len(o.ActionConfig) == 0 || string(o.ActionConfig) == "null" {
+		return nil
+	}
+	return json.Unmarshal(o.ActionConfig, v)
+}
+
+// RuleRequest is the payload to create or update a rule. Action holds the
+// fields specific to the rule type and is sent alongside the criteria.
+type RuleRequest struct {
+	Name           string   `json:"name"`
+	Url            []string `json:"url"`
+	Domain         []string `json:"domain"`
+	Disabled       bool     `json:"disabled"`
+	OnlyWithCookie bool     `json:"only_with_cookie"`
+	CookieName     string   `json:"cookie_name,omitempty"`
+	Method         string   `json:"method"`
+	MethodIs       []string `json:"method_is"`
+	MethodIsNot    []string `json:"method_is_not"`
+	Ip             string   `json:"ip"`
+	IpIs           []string `json:"ip_is"`
+	IpIsNot        []string `json:"ip_is_not"`
+	Country        string   `json:"country"`
+	CountryIs      []string `json:"country_is"`
+	CountryIsNot   []string `json:"country_is_not"`
+
+	Action map[string]interface{} `json:"-"`
+}
+
+func (o *RuleRequest) SetName(v string)           { o.Name = v }
+func (o *RuleRequest) SetUrl(v []string)          { o.Url = v }
+func (o *RuleRequest) SetDomain(v []string)       { o.Domain = v }
+func (o *RuleRequest) SetDisabled(v bool)         { o.Disabled = v }
+func (o *RuleRequest) SetOnlyWithCookie(v bool)   { o.OnlyWithCookie = v }
+func (o *RuleRequest) SetCookieName(v string)     { o.CookieName = v }
+func (o *RuleRequest) SetMethod(v string)         { o.Method = v }
+func (o *RuleRequest) SetMethodIs(v []string)     { o.MethodIs = v }
+func (o *RuleRequest) SetMethodIsNot(v []string)  { o.MethodIsNot = v }
+func (o *RuleRequest) SetIp(v string)             { o.Ip = v }
+func (o *RuleRequest) SetIpIs(v []string)         { o.IpIs = v }
+func (o *RuleRequest) SetIpIsNot(v []string)      { o.IpIsNot = v }
+func (o *RuleRequest) SetCountry(v string)        { o.Country = v }
+func (o *RuleRequest) SetCountryIs(v []string)    { o.CountryIs = v }
+func (o *RuleRequest) SetCountryIsNot(v []string) { o.CountryIsNot = v }
+
+func (o RuleRequest) MarshalJSON() ([]byte, error) {
+	type criteria RuleRequest
+	b, err := json.Marshal(criteria(o))
+	if err != nil {
+		return nil, err
+	}
+
+	payload := map[string]interface{}{}
+	if err := json.Unmarshal(b, &payload); err != nil {
+		return nil, err
+	}
+	for k, v := range o.Action {
+		payload[k] = v
+	}
+
+	return json.Marshal(payload)
+}
+
+func rulesPath(organization string, project string, ruleType string) string {
+	return fmt.Sprintf(
+		"/organizations/%s/projects/%s/rules/%s",
+		url.PathEscape(organization),
+		url.PathEscape(project),
+		url.PathEscape(ruleType),
+	)
+}
+
+// RulesList lists the rules of a type in a project.
+func (c *Client) RulesList(ctx context.Context, organization string, project string, ruleType string) ([]Rule, *http.Response, error) {
+	var rules []Rule
+	res, err := c.Do(ctx, http.MethodGet, rulesPath(organization, project, ruleType), nil, &rules)
+	return rules, res, err
+}
+
+// RulesCreate adds a rule of the given type to a project.
+func (c *Client) RulesCreate(ctx context.Context, organization string, project string, ruleType string, req RuleRequest) (*Rule, *http.Response, error) {
+	var rule Rule
+	res, err := c.Do(ctx, http.MethodPost, rulesPath(organization, project, ruleType), req, &rule)
+	return &rule, res, err
+}
+
+// RulesRead loads a single rule.
+func (c *Client) RulesRead(ctx context.Context, organization string, project string, ruleType string, rule string) (*Rule, *http.Response, error) {
+	var api Rule
+	res, err := c.Do(ctx, http.MethodGet, rulesPath(organization, project, ruleType)+"/"+url.PathEscape(rule), nil, &api)
+	return &api, res, err
+}
+
+// RulesUpdate changes an existing rule.
+func (c *Client) RulesUpdate(ctx context.Context, organization string, project string, ruleType string, rule string, req RuleRequest) (*Rule, *http.Response, error) {
+	var api Rule
+	res, err := c.Do(ctx, http.MethodPatch, rulesPath(organization, project, ruleType)+"/"+url.PathEscape(rule), req, &api)
+	return &api, res, err
+}
+
+// RulesDelete removes a rule from a project.
+func (c *Client) RulesDelete(ctx context.Context, organization string, project string, ruleType string, rule string) (*http.Response, error) {
+	return c.Do(ctx, http.MethodDelete, rulesPath(organization, project, ruleType)+"/"+url.PathEscape(rule), nil, nil)
+}
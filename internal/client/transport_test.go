@@ -0,0 +1,83 @@
+package client
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// testCertificate returns a self signed client certificate.
+func testCertificate(t *testing.T) tls.Certificate {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.Nil(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "terraform"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	assert.Nil(t, err)
+
+	return tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key}
+}
+
+func TestBaseTransportTLS(t *testing.T) {
+	var clientCerts int
+	srv := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		clientCerts = len(r.TLS.PeerCertificates)
+	}))
+	srv.TLS = &tls.Config{ClientAuth: tls.RequestClientCert}
+	srv.StartTLS()
+	defer srv.Close()
+
+	get := func(opts Options) error {
+		opts.Endpoint = srv.URL
+		_, err := New(context.Background(), "token", "quant", opts).Do(context.Background(), http.MethodGet, "/", nil, nil)
+		return err
+	}
+
+	assert.NotNil(t, get(Options{}), "the test server certificate is not trusted by default")
+
+	ca := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: srv.Certificate().Raw})
+	assert.Nil(t, get(Options{RootCAs: ca}))
+	assert.Equal(t, 0, clientCerts)
+
+	assert.Nil(t, get(Options{RootCAs: ca, Certificates: []tls.Certificate{testCertificate(t)}}))
+	assert.Equal(t, 1, clientCerts)
+
+	assert.Nil(t, get(Options{InsecureSkipVerify: true}))
+}
+
+func TestBaseTransportProxy(t *testing.T) {
+	var proxied string
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		proxied = r.URL.String()
+		w.Header().Set("X-Proxy-Authorization", r.Header.Get("Proxy-Authorization"))
+	}))
+	defer proxy.Close()
+
+	proxyURL, _ := url.Parse(proxy.URL)
+	proxyURL.User = url.UserPassword("ci", "secret")
+
+	c := New(context.Background(), "token", "quant", Options{Endpoint: "http://api.quant.test/api/v2", Proxy: proxyURL})
+	res, err := c.Do(context.Background(), http.MethodGet, "/organizations/quant", nil, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, "http://api.quant.test/api/v2/organizations/quant", proxied)
+	assert.Equal(t, "Basic Y2k6c2VjcmV0", res.Header.Get("X-Proxy-Authorization"))
+}
@@ -0,0 +1,201 @@
+package provider
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"terraform-provider-quant/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	openapi "github.com/quantcdn/quant-admin-go"
+)
+
+// apiErrorBody is the error document returned by the Admin API. Validation
+// failures list messages per request field in Errors.
+type apiErrorBody struct {
+	Message string              `json:"message"`
+	Error   string              `json:"error"`
+	Errors  map[string][]string `json:"errors"`
+}
+
+// apiFields maps the request fields a resource sends to the attributes that
+// hold them, eg. a redirect rule's "status_code" is its redirect_code.
+type apiFields map[string]string
+
+// apiErrorDiagnostics turns an error from quant-admin-go or the client into
+// diagnostics that say what went wrong and what to do about it. Validation
+// errors are attached to the attribute that fields maps the request field
+// to, errors for other fields are reported against the resource.
+//
+// The request ID of the failed call is added to each detail so it can be
+// quoted to QuantCDN support.
+func apiErrorDiagnostics(summary string, res *http.Response, err error, fields apiFields) diag.Diagnostics {
+	diags := apiStatusDiagnostics(summary, res, err, fields)
+
+	id := apiRequestID(res)
+	if id == "" {
+		return diags
+	}
+
+	withID := make(diag.Diagnostics, 0, len(diags))
+	for _, d := range diags {
+		detail := fmt.Sprintf("%s\n\nRequest ID: %s", d.Detail(), id)
+		if p, ok := d.(diag.DiagnosticWithPath); ok {
+			withID = append(withID, diag.NewAttributeErrorDiagnostic(p.Path(), d.Summary(), detail))
+		} else {
+			withID = append(withID, diag.NewErrorDiagnostic(d.Summary(), detail))
+		}
+	}
+
+	return withID
+}
+
+// apiStatusDiagnostics explains a failed call based on its HTTP status.
+func apiStatusDiagnostics(summary string, res *http.Response, err error, fields apiFields) (diags diag.Diagnostics) {
+	status, body := apiErrorDetails(res, err)
+
+	var apiErr apiErrorBody
+	_ = json.Unmarshal(body, &apiErr)
+
+	message := apiErr.Message
+	if message == "" {
+		message = apiErr.Error
+	}
+	if message == "" && len(body) > 0 && !json.Valid(body) {
+		message = strings.TrimSpace(string(body))
+	}
+	if message == "" && err != nil {
+		message = err.Error()
+	}
+
+	switch {
+	case status == http.StatusUnauthorized || status == http.StatusForbidden:
+		diags.AddError(
+			summary,
+			fmt.Sprintf("The QuantCDN API rejected the credentials (HTTP %d): %s\n\n"+
+				"Check that the bearer token is valid and has access to the organization.", status, message),
+		)
+	case status == http.StatusNotFound:
+		diags.AddError(
+			summary,
+			fmt.Sprintf("The object was not found (HTTP 404): %s\n\n"+
+				"Check the organization and project names, or whether it was deleted outside of Terraform.", message),
+		)
+	case status == http.StatusConflict:
+		diags.AddError(
+			summary,
+			fmt.Sprintf("The request conflicts with an existing object (HTTP 409): %s\n\n"+
+				"Import the existing object or choose a different name.", message),
+		)
+	case status == http.StatusBadRequest || status == http.StatusUnprocessableEntity:
+		if len(apiErr.Errors) == 0 {
+			diags.AddError(summary, fmt.Sprintf("The QuantCDN API rejected the request (HTTP %d): %s", status, message))
+			return
+		}
+
+		names := make([]string, 0, len(apiErr.Errors))
+		for field := range apiErr.Errors {
+			names = append(names, field)
+		}
+		sort.Strings(names)
+
+		for _, field := range names {
+			p, ok := apiErrorPath(fields, field)
+			if !ok {
+				diags.AddError(summary, fmt.Sprintf("%s: %s", field, strings.Join(apiErr.Errors[field], "\n")))
+				continue
+			}
+			diags.AddAttributeError(
+				p,
+				summary,
+				strings.Join(apiErr.Errors[field], "\n"),
+			)
+		}
+	case status == http.StatusTooManyRequests:
+		diags.AddError(
+			summary,
+			fmt.Sprintf("The QuantCDN API is rate limiting requests (HTTP 429): %s\n\n"+
+				"Retries were exhausted, consider raising max_retries or lowering max_concurrent_requests.", message),
+		)
+	case status >= 500:
+		diags.AddError(
+			summary,
+			fmt.Sprintf("The QuantCDN API failed to handle the request (HTTP %d): %s\n\n"+
+				"This is usually temporary, please try again later.", status, message),
+		)
+	case status > 0:
+		diags.AddError(summary, fmt.Sprintf("Unexpected response from the QuantCDN API (HTTP %d): %s", status, message))
+	default:
+		diags.AddError(summary, fmt.Sprintf("Unable to reach the QuantCDN API: %s", message))
+	}
+
+	return
+}
+
+// apiRequestID returns the request ID sent with a failed call, or echoed back
+// by the API when the request is not available.
+func apiRequestID(res *http.Response) string {
+	if res == nil {
+		return ""
+	}
+	if res.Request != nil {
+		if id := res.Request.Header.Get(client.RequestIDHeader); id != "" {
+			return id
+		}
+	}
+	return res.Header.Get(client.RequestIDHeader)
+}
+
+// apiErrorDetails finds the HTTP status and response body of a failed call.
+func apiErrorDetails(res *http.Response, err error) (int, []byte) {
+	var status int
+	var body []byte
+
+	if res != nil {
+		status = res.StatusCode
+	}
+
+	var sdkErr *openapi.GenericOpenAPIError
+	var clientErr *client.APIError
+	switch {
+	case errors.As(err, &sdkErr):
+		body = sdkErr.Body()
+		if status == 0 {
+			// The SDK only keeps the status line, eg. "422 Unprocessable Entity".
+			status, _ = strconv.Atoi(strings.SplitN(sdkErr.Error(), " ", 2)[0])
+		}
+	case errors.As(err, &clientErr):
+		body = clientErr.Body
+		if status == 0 {
+			status = clientErr.StatusCode
+		}
+	}
+
+	return status, body
+}
+
+// apiErrorPath maps a request field such as "url.0" or
+// "notify_config.origin_status_codes" to the matching attribute path. It is
+// false when fields has no attribute for the request field.
+func apiErrorPath(fields apiFields, field string) (path.Path, bool) {
+	parts := strings.Split(field, ".")
+	name, ok := fields[parts[0]]
+	if !ok {
+		return path.Empty(), false
+	}
+
+	p := path.Root(name)
+	for _, part := range parts[1:] {
+		if i, err := strconv.ParseInt(part, 10, 64); err == nil {
+			p = p.AtListIndex(int(i))
+			continue
+		}
+		p = p.AtName(part)
+	}
+	return p, true
+}
@@ -0,0 +1,59 @@
+package provider
+
+import (
+	"net/http"
+	"strings"
+	"terraform-provider-quant/internal/client"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAPIErrorDiagnostics(t *testing.T) {
+	apiError := func(status int, body string) error {
+		return &client.APIError{StatusCode: status, Status: http.StatusText(status), Body: []byte(body)}
+	}
+
+	fields := apiFields{"name": "name", "url": "url"}
+	diags := apiErrorDiagnostics("Unable to add the project", nil, apiError(422, `{"message":"The given data was invalid.","errors":{"name":["The name has already been taken."],"url.1":["The url.1 field is invalid."]}}`), fields)
+	assert.Equal(t, 2, diags.ErrorsCount())
+	assert.Equal(t, path.Root("name"), diags[0].(diag.DiagnosticWithPath).Path())
+	assert.Equal(t, "The name has already been taken.", diags[0].Detail())
+	assert.Equal(t, path.Root("url").AtListIndex(1), diags[1].(diag.DiagnosticWithPath).Path())
+
+	// Request fields are mapped to the attribute that holds them.
+	diags = apiErrorDiagnostics("Failed to create rule", nil, apiError(422, `{"errors":{"status_code":["The status code must be a redirect."]}}`), ruleRedirectAPIFields)
+	assert.Equal(t, path.Root("redirect_code"), diags[0].(diag.DiagnosticWithPath).Path())
+
+	// Fields without an attribute are reported against the resource.
+	diags = apiErrorDiagnostics("Failed to create rule", nil, apiError(422, `{"errors":{"action":["The action is invalid."]}}`), ruleRedirectAPIFields)
+	assert.Equal(t, 1, diags.ErrorsCount())
+	_, ok := diags[0].(diag.DiagnosticWithPath)
+	assert.False(t, ok)
+	assert.Equal(t, "action: The action is invalid.", diags[0].Detail())
+
+	for status, want := range map[int]string{
+		401: "rejected the credentials",
+		403: "rejected the credentials",
+		404: "not found",
+		409: "conflicts with an existing object",
+		429: "rate limiting",
+		503: "failed to handle the request",
+	} {
+		diags := apiErrorDiagnostics("Failed to read rule", nil, apiError(status, `{"message":"nope"}`), nil)
+		assert.Equal(t, 1, diags.ErrorsCount())
+		assert.True(t, strings.Contains(diags[0].Detail(), want), diags[0].Detail())
+		assert.True(t, strings.Contains(diags[0].Detail(), "nope"), diags[0].Detail())
+	}
+
+	diags = apiErrorDiagnostics("Failed to read rule", &http.Response{StatusCode: 500}, apiError(500, "upstream timed out"), nil)
+	assert.True(t, strings.Contains(diags[0].Detail(), "upstream timed out"))
+
+	req, _ := http.NewRequest(http.MethodGet, "https://dashboard.quantcdn.io/api/v2/organizations/quant", nil)
+	req.Header.Set(client.RequestIDHeader, "operation-1")
+	diags = apiErrorDiagnostics("Unable to add the project", &http.Response{StatusCode: 422, Request: req}, apiError(422, `{"errors":{"name":["The name has already been taken."]}}`), projectAPIFields)
+	assert.Equal(t, path.Root("name"), diags[0].(diag.DiagnosticWithPath).Path())
+	assert.Equal(t, "The name has already been taken.\n\nRequest ID: operation-1", diags[0].Detail())
+}
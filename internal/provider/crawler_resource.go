@@ -2,23 +2,43 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"strings"
 	"terraform-provider-quant/internal/client"
 	"terraform-provider-quant/internal/resource_crawler"
+	"terraform-provider-quant/internal/utils"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	openapi "github.com/quantcdn/quant-admin-go"
 )
 
 var (
-	_ resource.Resource              = (*crawlerResource)(nil)
-	_ resource.ResourceWithConfigure = (*crawlerResource)(nil)
+	_ resource.Resource                = (*crawlerResource)(nil)
+	_ resource.ResourceWithConfigure   = (*crawlerResource)(nil)
+	_ resource.ResourceWithImportState = (*crawlerResource)(nil)
+	_ resource.ResourceWithModifyPlan  = (*crawlerResource)(nil)
 )
 
+// crawlerAPIFields maps crawler request fields to their attributes.
+var crawlerAPIFields = apiFields{
+	"name":         "name",
+	"domain":       "domain",
+	"browser_mode": "browser_mode",
+	"url_list":     "url_list",
+	"headers":      "headers",
+}
+
 func NewCrawlerResource() resource.Resource {
 	return &crawlerResource{}
 }
@@ -27,12 +47,38 @@ type crawlerResource struct {
 	client *client.Client
 }
 
+// crawlerResourceModel adds the timeouts block to the generated model.
+type crawlerResourceModel struct {
+	resource_crawler.CrawlerModel
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
 func (r *crawlerResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_crawler"
 }
 
 func (r *crawlerResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = resource_crawler.CrawlerResourceSchema(ctx)
+	resp.Schema.Attributes["organization"] = organizationAttribute()
+	resp.Schema.Blocks = timeoutsBlocks(ctx)
+	resp.Schema.Attributes["project"] = projectAttribute()
+
+	// These are set when the crawler is created and do not change after,
+	// config, urls_list and updated_at change with every update.
+	for _, name := range []string{"uuid", "created_at", "deleted_at"} {
+		attr := resp.Schema.Attributes[name].(schema.StringAttribute)
+		attr.PlanModifiers = []planmodifier.String{stringplanmodifier.UseStateForUnknown()}
+		resp.Schema.Attributes[name] = attr
+	}
+	for _, name := range []string{"id", "project_id"} {
+		attr := resp.Schema.Attributes[name].(schema.Int64Attribute)
+		attr.PlanModifiers = []planmodifier.Int64{int64planmodifier.UseStateForUnknown()}
+		resp.Schema.Attributes[name] = attr
+	}
+}
+
+func (r *crawlerResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyPlanProject(ctx, r.client, req, resp)
 }
 
 func (r *crawlerResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -50,7 +96,7 @@ func (r *crawlerResource) Configure(_ context.Context, req resource.ConfigureReq
 }
 
 func (r *crawlerResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var data resource_crawler.CrawlerModel
+	var data crawlerResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -59,7 +105,16 @@ func (r *crawlerResource) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 
-	resp.Diagnostics.Append(callCrawlerCreateAPI(ctx, r, &data)...)
+	ctx, cancel := operationContext(ctx, data.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
+	resp.Diagnostics.Append(callCrawlerCreateAPI(ctx, r, &data.CrawlerModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Read the API results back into the model for Terraform state.
+	resp.Diagnostics.Append(callCrawlerReadAPI(ctx, r, &data.CrawlerModel)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -69,7 +124,7 @@ func (r *crawlerResource) Create(ctx context.Context, req resource.CreateRequest
 }
 
 func (r *crawlerResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var data resource_crawler.CrawlerModel
+	var data crawlerResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -78,17 +133,26 @@ func (r *crawlerResource) Read(ctx context.Context, req resource.ReadRequest, re
 		return
 	}
 
-	resp.Diagnostics.Append(callCrawlerReadAPI(ctx, r, &data)...)
+	ctx, cancel := operationContext(ctx, data.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
+	resp.Diagnostics.Append(callCrawlerReadAPI(ctx, r, &data.CrawlerModel)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	// The crawler was removed outside of Terraform.
+	if data.Uuid.IsNull() {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *crawlerResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data resource_crawler.CrawlerModel
+	var data crawlerResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -97,8 +161,20 @@ func (r *crawlerResource) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 
+	ctx, cancel := operationContext(ctx, data.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
+	var state crawlerResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	data.Uuid = state.Uuid
+
 	// Update the crawler object.
-	resp.Diagnostics.Append(callCrawlerUpdateAPI(ctx, r, &data)...)
+	resp.Diagnostics.Append(callCrawlerUpdateAPI(ctx, r, &data.CrawlerModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(callCrawlerReadAPI(ctx, r, &data.CrawlerModel)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -108,7 +184,7 @@ func (r *crawlerResource) Update(ctx context.Context, req resource.UpdateRequest
 }
 
 func (r *crawlerResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data resource_crawler.CrawlerModel
+	var data crawlerResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -117,39 +193,166 @@ func (r *crawlerResource) Delete(ctx context.Context, req resource.DeleteRequest
 		return
 	}
 
+	ctx, cancel := operationContext(ctx, data.Timeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	// Delete API call logic
-	resp.Diagnostics.Append(callCrawlerDeleteAPI(ctx, r, &data)...)
+	resp.Diagnostics.Append(callCrawlerDeleteAPI(ctx, r, &data.CrawlerModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Import a crawler with project/uuid.
+func (r *crawlerResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	var data crawlerResourceModel
+	var err error
+	data.Project, data.Uuid, err = utils.GetRuleImportId(req.ID)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			err.Error(),
+		)
+		return
+	}
+
+	data.Organization = types.StringNull()
+	data.Headers = types.MapNull(types.StringType)
+	data.UrlList = types.ListNull(types.StringType)
+
+	// Read API call logic
+	resp.Diagnostics.Append(callCrawlerReadAPI(ctx, r, &data.CrawlerModel)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	if data.Uuid.IsNull() {
+		resp.Diagnostics.AddError(
+			"Unable to import crawler",
+			fmt.Sprintf("No crawler matching %s was found.", req.ID),
+		)
+		return
+	}
+
+	// An imported resource has no timeouts block.
+	resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("timeouts"), &data.Timeouts)...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// crawlerConfig is the subset of the stored crawler configuration that maps
+// back onto resource attributes.
+type crawlerConfig struct {
+	BrowserMode *bool             `json:"browser_mode"`
+	UrlList     []string          `json:"url_list"`
+	Headers     map[string]string `json:"headers"`
+}
+
+// getCrawlerConfig decodes the crawler configuration, the API stores this as
+// a JSON string but may also return the values as top level properties.
+func getCrawlerConfig(api *openapi.Crawler) (config crawlerConfig, diags diag.Diagnostics) {
+	if api.GetConfig() != "" {
+		if err := json.Unmarshal([]byte(api.GetConfig()), &config); err != nil {
+			diags.AddError(
+				"Unable to decode crawler configuration",
+				fmt.Sprintf("The configuration of crawler %s could not be read: %s", api.GetUuid(), err),
+			)
+			return
+		}
+	}
+
+	if len(api.AdditionalProperties) > 0 {
+		b, err := json.Marshal(api.AdditionalProperties)
+		if err == nil {
+			err = json.Unmarshal(b, &config)
+		}
+		if err != nil {
+			diags.AddError(
+				"Unable to decode crawler configuration",
+				fmt.Sprintf("The properties of crawler %s could not be read: %s", api.GetUuid(), err),
+			)
+			return
+		}
+	}
+
+	// Fall back to the flattened URL list.
+	if config.UrlList == nil && api.GetUrlsList() != "" {
+		if err := json.Unmarshal([]byte(api.GetUrlsList()), &config.UrlList); err != nil {
+			config.UrlList = nil
+			for _, u := range strings.Split(api.GetUrlsList(), "\n") {
+				if u = strings.TrimSpace(u); u != "" {
+					config.UrlList = append(config.UrlList, u)
+				}
+			}
+		}
+	}
+
+	return
+}
+
+// sameStrings reports whether a and b hold the same strings, ignoring order
+// and duplicates.
+func sameStrings(a []string, b []string) bool {
+	set := make(map[string]bool, len(a))
+	for _, v := range a {
+		set[v] = true
+	}
+
+	seen := make(map[string]bool, len(b))
+	for _, v := range b {
+		if !set[v] {
+			return false
+		}
+		seen[v] = true
+	}
+
+	return len(seen) == len(set)
 }
 
 func callCrawlerCreateAPI(ctx context.Context, r *crawlerResource, crawler *resource_crawler.CrawlerModel) (diags diag.Diagnostics) {
+	if crawler.Project.IsNull() || crawler.Project.IsUnknown() {
+		diags.AddAttributeError(
+			path.Root("project"),
+			"Missing crawler.project attribute",
+			"To create a crawler, project must be provided.",
+		)
+		return
+	}
+
+	org := organizationValue(r.client, crawler.Organization)
+
 	req := *openapi.NewCrawlerRequestWithDefaults()
 
 	req.SetBrowserMode(crawler.BrowserMode.ValueBool())
 	req.SetDomain(crawler.Domain.ValueString())
-	req.SetName(crawler.Name.ValueString())
+	if !crawler.Name.IsNull() && !crawler.Name.IsUnknown() {
+		req.SetName(crawler.Name.ValueString())
+	}
 
 	urls := make([]string, 0, len(crawler.UrlList.Elements()))
 	diags.Append(crawler.UrlList.ElementsAs(ctx, &urls, false)...)
 
 	req.SetUrlList(urls)
 
-	// @todo: Support custom headers.
-	// @todo: API to support crawler config overrides.
+	headers := make(map[string]string, len(crawler.Headers.Elements()))
+	diags.Append(crawler.Headers.ElementsAs(ctx, &headers, false)...)
 
-	api, _, err := r.client.Instance.CrawlersAPI.CrawlersCreate(r.client.AuthContext, r.client.Organization, crawler.Project.ValueString()).CrawlerRequest(req).Execute()
+	req.SetHeaders(headers)
+
+	if diags.HasError() {
+		return
+	}
+
+	api, res, err := r.client.Instance.CrawlersAPI.CrawlersCreate(r.client.RequestContext(ctx), org, crawler.Project.ValueString()).CrawlerRequest(req).Execute()
 
 	if err != nil {
-		diags.AddError(
-			"Unable to create crawler",
-			fmt.Sprintf("Error: %s", err.Error()),
-		)
+		diags.Append(apiErrorDiagnostics("Unable to create crawler", res, err, crawlerAPIFields)...)
 		return diags
 	}
 
 	crawler.Uuid = types.StringValue(api.Uuid)
+	crawler.Organization = types.StringValue(org)
 
 	return diags
 }
@@ -173,23 +376,73 @@ func callCrawlerReadAPI(ctx context.Context, r *crawlerResource, crawler *resour
 		return
 	}
 
-	org := r.client.Organization
-	if !crawler.Organization.IsNull() {
-		org = crawler.Organization.ValueString()
-	}
+	org := organizationValue(r.client, crawler.Organization)
 
-	api, _, err := r.client.Instance.CrawlersAPI.CrawlersRead(ctx, org, crawler.Project.ValueString(), crawler.Uuid.ValueString()).Execute()
+	api, res, err := r.client.Instance.CrawlersAPI.CrawlersRead(r.client.RequestContext(ctx), org, crawler.Project.ValueString(), crawler.Uuid.ValueString()).Execute()
+	if utils.IsNotFound(res) {
+		// Signal to the caller that the crawler no longer exists.
+		crawler.Uuid = types.StringNull()
+		return
+	}
 	if err != nil {
-		diags.AddError("Unable to load crawler", fmt.Sprintf("Error: %s", err.Error()))
+		diags.Append(apiErrorDiagnostics("Unable to load crawler", res, err, nil)...)
 		return
 	}
 
-	// @todo API to support browser mode.
-	// crawler.BrowserMode = types.BoolValue(api.GetBrowserMode())
-
+	crawler.Organization = types.StringValue(org)
+	crawler.Uuid = types.StringValue(api.GetUuid())
+	crawler.Id = types.Int64Value(int64(api.GetId()))
+	crawler.ProjectId = types.Int64Value(int64(api.GetProjectId()))
+	crawler.Name = types.StringValue(api.GetName())
+	crawler.Config = types.StringValue(api.GetConfig())
 	crawler.CreatedAt = types.StringValue(api.GetCreatedAt())
+	crawler.UpdatedAt = types.StringValue(api.GetUpdatedAt())
+	crawler.DeletedAt = types.StringPointerValue(api.DeletedAt)
 	crawler.Domain = types.StringValue(api.GetDomain())
 	crawler.DomainVerified = types.Int64Value(int64(api.GetDomainVerified()))
+	crawler.UrlsList = types.StringValue(api.GetUrlsList())
+
+	if crawler.Crawler.IsNull() || crawler.Crawler.IsUnknown() {
+		crawler.Crawler = types.StringValue(api.GetUuid())
+	}
+
+	config, d := getCrawlerConfig(api)
+	if d.HasError() {
+		diags.Append(d...)
+		return
+	}
+
+	if config.BrowserMode != nil {
+		crawler.BrowserMode = types.BoolValue(*config.BrowserMode)
+	} else if crawler.BrowserMode.IsNull() || crawler.BrowserMode.IsUnknown() {
+		crawler.BrowserMode = types.BoolValue(false)
+	}
+
+	// Keep the configured order when the API reorders or dedupes the URLs.
+	var planned []string
+	if !crawler.UrlList.IsNull() && !crawler.UrlList.IsUnknown() {
+		diags.Append(crawler.UrlList.ElementsAs(ctx, &planned, false)...)
+	}
+
+	if config.UrlList != nil && (planned == nil || !sameStrings(planned, config.UrlList)) {
+		urls, d := types.ListValueFrom(ctx, types.StringType, config.UrlList)
+		if d.HasError() {
+			diags.Append(d...)
+			return
+		}
+		crawler.UrlList = urls
+	}
+
+	if config.Headers != nil {
+		headers, d := types.MapValueFrom(ctx, types.StringType, config.Headers)
+		if d.HasError() {
+			diags.Append(d...)
+			return
+		}
+		crawler.Headers = headers
+	} else if crawler.Headers.IsNull() || crawler.Headers.IsUnknown() {
+		crawler.Headers = types.MapValueMust(types.StringType, map[string]attr.Value{})
+	}
 
 	return
 }
@@ -213,14 +466,11 @@ func callCrawlerDeleteAPI(ctx context.Context, r *crawlerResource, crawler *reso
 		return
 	}
 
-	org := r.client.Organization
-	if !crawler.Organization.IsNull() {
-		org = crawler.Organization.ValueString()
-	}
+	org := organizationValue(r.client, crawler.Organization)
 
-	_, _, err := r.client.Instance.CrawlersAPI.CrawlersDelete(ctx, org, crawler.Project.ValueString(), crawler.Uuid.ValueString()).Execute()
-	if err != nil {
-		diags.AddError("Unable to delete crawler", fmt.Sprintf("Error: %s", err.Error()))
+	_, res, err := r.client.Instance.CrawlersAPI.CrawlersDelete(r.client.RequestContext(ctx), org, crawler.Project.ValueString(), crawler.Uuid.ValueString()).Execute()
+	if err != nil && !utils.IsNotFound(res) {
+		diags.Append(apiErrorDiagnostics("Unable to delete crawler", res, err, nil)...)
 	}
 	return diags
 }
@@ -244,24 +494,33 @@ func callCrawlerUpdateAPI(ctx context.Context, r *crawlerResource, crawler *reso
 		return
 	}
 
-	org := r.client.Organization
-	if !crawler.Organization.IsNull() {
-		org = crawler.Organization.ValueString()
-	}
+	org := organizationValue(r.client, crawler.Organization)
 
 	req := *openapi.NewCrawlerRequestUpdateWithDefaults()
 
 	req.SetDomain(crawler.Domain.ValueString())
 	req.SetBrowserMode(crawler.BrowserMode.ValueBool())
+	if !crawler.Name.IsNull() && !crawler.Name.IsUnknown() {
+		req.SetName(crawler.Name.ValueString())
+	}
 
 	urls := make([]string, 0, len(crawler.UrlList.Elements()))
 	diags.Append(crawler.UrlList.ElementsAs(ctx, &urls, false)...)
 
 	req.SetUrlList(urls)
 
-	api, _, err := r.client.Instance.CrawlersAPI.CrawlersUpdate(ctx, org, crawler.Project.ValueString(), crawler.Uuid.ValueString()).CrawlerRequestUpdate(req).Execute()
+	headers := make(map[string]string, len(crawler.Headers.Elements()))
+	diags.Append(crawler.Headers.ElementsAs(ctx, &headers, false)...)
+
+	req.SetHeaders(headers)
+
+	if diags.HasError() {
+		return
+	}
+
+	api, res, err := r.client.Instance.CrawlersAPI.CrawlersUpdate(r.client.RequestContext(ctx), org, crawler.Project.ValueString(), crawler.Uuid.ValueString()).CrawlerRequestUpdate(req).Execute()
 	if err != nil {
-		diags.AddError("Unable to update crawler", fmt.Sprintf("Error: %s", err.Error()))
+		diags.Append(apiErrorDiagnostics("Unable to update crawler", res, err, crawlerAPIFields)...)
 		return
 	}
 
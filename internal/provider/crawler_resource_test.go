@@ -7,6 +7,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	openapi "github.com/quantcdn/quant-admin-go"
 	// "github.com/stretchr/testify/assert"
 )
@@ -47,3 +48,55 @@ func TestCreateCrawler(t *testing.T) {
 
 	t.Logf("Crawler: %v", crawler)
 }
+
+func TestAccCrawlerResource(t *testing.T) {
+	api := newFakeAPI(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDestroyed(api, "crawlers"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(api) + `
+resource "quant_crawler" "test" {
+  project  = "api-test"
+  name     = "nightly"
+  domain   = "https://www.example.com"
+  url_list = ["/", "/about"]
+  headers  = {}
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("quant_crawler.test", "uuid"),
+					resource.TestCheckResourceAttr("quant_crawler.test", "url_list.#", "2"),
+				),
+			},
+			{
+				Config: testAccProviderConfig(api) + `
+resource "quant_crawler" "test" {
+  project      = "api-test"
+  name         = "nightly"
+  domain       = "https://www.example.com"
+  browser_mode = true
+  url_list     = ["/about", "/", "/contact"]
+  headers = {
+    "User-Agent" = "Quant Crawler"
+  }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("quant_crawler.test", "browser_mode", "true"),
+					resource.TestCheckResourceAttr("quant_crawler.test", "url_list.0", "/about"),
+					resource.TestCheckResourceAttr("quant_crawler.test", "headers.User-Agent", "Quant Crawler"),
+				),
+			},
+			{
+				ResourceName:                         "quant_crawler.test",
+				ImportState:                          true,
+				ImportStateIdFunc:                    testAccRuleImportId("quant_crawler.test"),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "uuid",
+			},
+		},
+	})
+}
@@ -0,0 +1,379 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-quant/internal/client"
+	"terraform-provider-quant/internal/utils"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource               = (*crawlerRunResource)(nil)
+	_ resource.ResourceWithConfigure  = (*crawlerRunResource)(nil)
+	_ resource.ResourceWithModifyPlan = (*crawlerRunResource)(nil)
+)
+
+// How often a run is polled while waiting for it to complete.
+var crawlerRunPollInterval = 10 * time.Second
+
+func NewCrawlerRunResource() resource.Resource {
+	return &crawlerRunResource{}
+}
+
+type crawlerRunResource struct {
+	client *client.Client
+}
+
+type crawlerRunResourceModel struct {
+	Id                types.Int64    `tfsdk:"id"`
+	Organization      types.String   `tfsdk:"organization"`
+	Project           types.String   `tfsdk:"project"`
+	Crawler           types.String   `tfsdk:"crawler"`
+	Triggers          types.Map      `tfsdk:"triggers"`
+	WaitForCompletion types.Bool     `tfsdk:"wait_for_completion"`
+	CompletionTimeout types.String   `tfsdk:"completion_timeout"`
+	Status            types.String   `tfsdk:"status"`
+	UrlsTotal         types.Int64    `tfsdk:"urls_total"`
+	UrlsCrawled       types.Int64    `tfsdk:"urls_crawled"`
+	UrlsFailed        types.Int64    `tfsdk:"urls_failed"`
+	StartedAt         types.String   `tfsdk:"started_at"`
+	CompletedAt       types.String   `tfsdk:"completed_at"`
+	CreatedAt         types.String   `tfsdk:"created_at"`
+	Timeouts          timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *crawlerRunResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_crawler_run"
+}
+
+func (r *crawlerRunResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{Create: true, Read: true}),
+		},
+		MarkdownDescription: "Starts a crawl when the resource is created or any of the `triggers` change.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Computed: true,
+			},
+			"organization": organizationAttribute(),
+			"project":      projectAttribute(),
+			"crawler": schema.StringAttribute{
+				MarkdownDescription: "The uuid of the crawler to run",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				MarkdownDescription: "Arbitrary values that start a new crawl when changed",
+				ElementType:         types.StringType,
+				Optional:            true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"wait_for_completion": schema.BoolAttribute{
+				MarkdownDescription: "Wait for the crawl to finish and fail the apply if it fails",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"completion_timeout": schema.StringAttribute{
+				MarkdownDescription: "How long to wait for the crawl to finish, eg. `30m`",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("30m"),
+				Validators: []validator.String{
+					durationValidator{},
+				},
+			},
+			"status": schema.StringAttribute{
+				Computed: true,
+			},
+			"urls_total": schema.Int64Attribute{
+				Computed: true,
+			},
+			"urls_crawled": schema.Int64Attribute{
+				Computed: true,
+			},
+			"urls_failed": schema.Int64Attribute{
+				Computed: true,
+			},
+			"started_at": schema.StringAttribute{
+				Computed: true,
+			},
+			"completed_at": schema.StringAttribute{
+				Computed: true,
+			},
+			"created_at": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (r *crawlerRunResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyPlanProject(ctx, r.client, req, resp)
+}
+
+func (r *crawlerRunResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected resource configure type",
+			fmt.Sprintf("Expected *internal.Client, got: %T. Please report this issue to the provider developers", req.ProviderData),
+		)
+	}
+	r.client = client
+}
+
+func (r *crawlerRunResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data crawlerRunResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Leave room to wait for the crawl on top of starting it.
+	wait, _ := time.ParseDuration(data.CompletionTimeout.ValueString())
+	createTimeout := func(ctx context.Context, d time.Duration) (time.Duration, diag.Diagnostics) {
+		return data.Timeouts.Create(ctx, wait+d)
+	}
+
+	ctx, cancel := operationContext(ctx, createTimeout, &resp.Diagnostics)
+	defer cancel()
+
+	resp.Diagnostics.Append(callCrawlerRunCreateAPI(ctx, r, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.WaitForCompletion.ValueBool() {
+		resp.Diagnostics.Append(waitForCrawlerRun(ctx, r, &data)...)
+
+		// Keep a failed run in state, Terraform taints it so the next
+		// apply starts a new crawl.
+		if resp.Diagnostics.HasError() {
+			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			return
+		}
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *crawlerRunResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data crawlerRunResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := operationContext(ctx, data.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
+	resp.Diagnostics.Append(callCrawlerRunReadAPI(ctx, r, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The run history was removed outside of Terraform.
+	if data.Id.IsNull() {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Only the wait settings can change in place, every other attribute
+// starts a new run.
+func (r *crawlerRunResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan crawlerRunResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state crawlerRunResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.WaitForCompletion = plan.WaitForCompletion
+	state.CompletionTimeout = plan.CompletionTimeout
+	state.Timeouts = plan.Timeouts
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Runs cannot be removed from the API, deleting only forgets the run.
+func (r *crawlerRunResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+}
+
+func callCrawlerRunCreateAPI(ctx context.Context, r *crawlerRunResource, run *crawlerRunResourceModel) (diags diag.Diagnostics) {
+	org := organizationValue(r.client, run.Organization)
+
+	api, res, err := r.client.CrawlersRun(r.client.RequestContext(ctx), org, run.Project.ValueString(), run.Crawler.ValueString())
+	if err != nil {
+		diags.Append(apiErrorDiagnostics("Unable to start crawler", res, err, nil)...)
+		return
+	}
+
+	run.Organization = types.StringValue(org)
+	setCrawlerRunModel(run, api)
+
+	return
+}
+
+func callCrawlerRunReadAPI(ctx context.Context, r *crawlerRunResource, run *crawlerRunResourceModel) (diags diag.Diagnostics) {
+	if run.Id.IsNull() || run.Id.IsUnknown() {
+		diags.AddAttributeError(
+			path.Root("id"),
+			"Missing crawler_run.id attribute",
+			"To read crawler run information, id must be provided.",
+		)
+		return
+	}
+
+	org := organizationValue(r.client, run.Organization)
+
+	api, res, err := r.client.CrawlerRunsRead(r.client.RequestContext(ctx), org, run.Project.ValueString(), run.Crawler.ValueString(), run.Id.ValueInt64())
+	if utils.IsNotFound(res) {
+		// Signal to the caller that the run no longer exists.
+		run.Id = types.Int64Null()
+		return
+	}
+	if err != nil {
+		diags.Append(apiErrorDiagnostics("Unable to read crawler run", res, err, nil)...)
+		return
+	}
+
+	run.Organization = types.StringValue(org)
+	setCrawlerRunModel(run, api)
+
+	return
+}
+
+// waitForCrawlerRun polls the run until it finishes, fails or the completion
+// timeout is reached.
+func waitForCrawlerRun(ctx context.Context, r *crawlerRunResource, run *crawlerRunResourceModel) (diags diag.Diagnostics) {
+	timeout, err := time.ParseDuration(run.CompletionTimeout.ValueString())
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("completion_timeout"),
+			"Invalid completion_timeout",
+			err.Error(),
+		)
+		return
+	}
+
+	deadline := time.Now().Add(timeout)
+	for {
+		diags.Append(callCrawlerRunReadAPI(ctx, r, run)...)
+		if diags.HasError() {
+			return
+		}
+
+		if run.Id.IsNull() {
+			diags.AddError("Crawler run not found", "The crawler run was removed while waiting for it to complete.")
+			return
+		}
+
+		status := client.CrawlerRun{Status: run.Status.ValueString()}
+		if status.Succeeded() {
+			return
+		}
+		if status.Failed() {
+			diags.AddError(
+				"Crawl failed",
+				fmt.Sprintf("Crawler run %d finished with status %q (%d of %d URLs failed).", run.Id.ValueInt64(), run.Status.ValueString(), run.UrlsFailed.ValueInt64(), run.UrlsTotal.ValueInt64()),
+			)
+			return
+		}
+
+		if time.Now().After(deadline) {
+			diags.AddError(
+				"Timed out waiting for crawl",
+				fmt.Sprintf("Crawler run %d was still %q after %s.", run.Id.ValueInt64(), run.Status.ValueString(), timeout),
+			)
+			return
+		}
+
+		select {
+		case <-ctx.Done():
+			diags.AddError("Cancelled waiting for crawl", ctx.Err().Error())
+			return
+		case <-time.After(crawlerRunPollInterval):
+		}
+	}
+}
+
+// setCrawlerRunModel copies the API response into the Terraform model.
+func setCrawlerRunModel(run *crawlerRunResourceModel, api *client.CrawlerRun) {
+	run.Id = types.Int64Value(api.Id)
+	run.Status = types.StringValue(api.Status)
+	run.UrlsTotal = types.Int64Value(api.UrlsTotal)
+	run.UrlsCrawled = types.Int64Value(api.UrlsCrawled)
+	run.UrlsFailed = types.Int64Value(api.UrlsFailed)
+	run.StartedAt = types.StringValue(api.StartedAt)
+	run.CompletedAt = types.StringValue(api.CompletedAt)
+	run.CreatedAt = types.StringValue(api.CreatedAt)
+}
+
+// durationValidator checks Go duration strings such as "30m" or "1h30m".
+type durationValidator struct{}
+
+func (v durationValidator) Description(ctx context.Context) string {
+	return "value must be a duration such as 30s, 10m or 1h"
+}
+
+func (v durationValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v durationValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if d, err := time.ParseDuration(req.ConfigValue.ValueString()); err != nil || d <= 0 {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid duration",
+			fmt.Sprintf("%q is not a valid duration, use a value such as 30s, 10m or 1h.", req.ConfigValue.ValueString()),
+		)
+	}
+}
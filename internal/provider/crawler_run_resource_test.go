@@ -0,0 +1,28 @@
+package provider_test
+
+import (
+	"terraform-provider-quant/internal/client"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCrawlerRunStatus(t *testing.T) {
+	for _, status := range []string{"completed", "Finished"} {
+		run := client.CrawlerRun{Status: status}
+		assert.True(t, run.Succeeded(), status)
+		assert.False(t, run.Failed(), status)
+	}
+
+	for _, status := range []string{"failed", "Cancelled"} {
+		run := client.CrawlerRun{Status: status}
+		assert.True(t, run.Failed(), status)
+		assert.False(t, run.Succeeded(), status)
+	}
+
+	for _, status := range []string{"queued", "running", ""} {
+		run := client.CrawlerRun{Status: status}
+		assert.False(t, run.Failed(), status)
+		assert.False(t, run.Succeeded(), status)
+	}
+}
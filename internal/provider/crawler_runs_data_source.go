@@ -0,0 +1,167 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"terraform-provider-quant/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = (*crawlerRunsDataSource)(nil)
+	_ datasource.DataSourceWithConfigure = (*crawlerRunsDataSource)(nil)
+)
+
+// Number of runs returned when limit is not set.
+const crawlerRunsDefaultLimit = 10
+
+func NewCrawlerRunsDataSource() datasource.DataSource {
+	return &crawlerRunsDataSource{}
+}
+
+type crawlerRunsDataSource struct {
+	client *client.Client
+}
+
+type crawlerRunsDataSourceModel struct {
+	Organization types.String      `tfsdk:"organization"`
+	Project      types.String      `tfsdk:"project"`
+	Crawler      types.String      `tfsdk:"crawler"`
+	Limit        types.Int64       `tfsdk:"limit"`
+	Runs         []crawlerRunModel `tfsdk:"runs"`
+}
+
+type crawlerRunModel struct {
+	Id          types.Int64  `tfsdk:"id"`
+	Status      types.String `tfsdk:"status"`
+	UrlsTotal   types.Int64  `tfsdk:"urls_total"`
+	UrlsCrawled types.Int64  `tfsdk:"urls_crawled"`
+	UrlsFailed  types.Int64  `tfsdk:"urls_failed"`
+	StartedAt   types.String `tfsdk:"started_at"`
+	CompletedAt types.String `tfsdk:"completed_at"`
+	CreatedAt   types.String `tfsdk:"created_at"`
+}
+
+func (d *crawlerRunsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_crawler_runs"
+}
+
+func (d *crawlerRunsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected data source configure type",
+			fmt.Sprintf("Expected *internal.Client, got: %T. Please report this issue to the provider developers", req.ProviderData),
+		)
+	}
+	d.client = client
+}
+
+func (d *crawlerRunsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"organization": organizationDataSourceAttribute(),
+			"project": schema.StringAttribute{
+				Required: true,
+			},
+			"crawler": schema.StringAttribute{
+				MarkdownDescription: "The uuid of the crawler",
+				Required:            true,
+			},
+			"limit": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of recent runs to return, defaults to 10",
+				Optional:            true,
+			},
+			"runs": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Computed: true,
+						},
+						"status": schema.StringAttribute{
+							Computed: true,
+						},
+						"urls_total": schema.Int64Attribute{
+							Computed: true,
+						},
+						"urls_crawled": schema.Int64Attribute{
+							Computed: true,
+						},
+						"urls_failed": schema.Int64Attribute{
+							Computed: true,
+						},
+						"started_at": schema.StringAttribute{
+							Computed: true,
+						},
+						"completed_at": schema.StringAttribute{
+							Computed: true,
+						},
+						"created_at": schema.StringAttribute{
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *crawlerRunsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data crawlerRunsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	org := organizationValue(d.client, data.Organization)
+	data.Organization = types.StringValue(org)
+
+	// Read API call logic
+	runs, res, err := d.client.CrawlerRunsList(d.client.RequestContext(ctx), org, data.Project.ValueString(), data.Crawler.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.Append(apiErrorDiagnostics(fmt.Sprintf("Unable to read runs for crawler %s", data.Crawler.ValueString()), res, err, nil)...)
+		return
+	}
+
+	// Most recent runs first.
+	sort.SliceStable(runs, func(i, j int) bool {
+		return runs[i].Id > runs[j].Id
+	})
+
+	limit := int64(crawlerRunsDefaultLimit)
+	if !data.Limit.IsNull() && data.Limit.ValueInt64() > 0 {
+		limit = data.Limit.ValueInt64()
+	}
+	if int64(len(runs)) > limit {
+		runs = runs[:limit]
+	}
+
+	data.Runs = []crawlerRunModel{}
+	for _, r := range runs {
+		data.Runs = append(data.Runs, crawlerRunModel{
+			Id:          types.Int64Value(r.Id),
+			Status:      types.StringValue(r.Status),
+			UrlsTotal:   types.Int64Value(r.UrlsTotal),
+			UrlsCrawled: types.Int64Value(r.UrlsCrawled),
+			UrlsFailed:  types.Int64Value(r.UrlsFailed),
+			StartedAt:   types.StringValue(r.StartedAt),
+			CompletedAt: types.StringValue(r.CompletedAt),
+			CreatedAt:   types.StringValue(r.CreatedAt),
+		})
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,404 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"terraform-provider-quant/internal/client"
+	"terraform-provider-quant/internal/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = (*crawlerScheduleResource)(nil)
+	_ resource.ResourceWithConfigure   = (*crawlerScheduleResource)(nil)
+	_ resource.ResourceWithImportState = (*crawlerScheduleResource)(nil)
+	_ resource.ResourceWithModifyPlan  = (*crawlerScheduleResource)(nil)
+)
+
+// crawlerScheduleAPIFields maps schedule request fields to their attributes.
+var crawlerScheduleAPIFields = apiFields{
+	"name":                 "name",
+	"schedule_cron_string": "schedule_cron_string",
+}
+
+func NewCrawlerScheduleResource() resource.Resource {
+	return &crawlerScheduleResource{}
+}
+
+type crawlerScheduleResource struct {
+	client *client.Client
+}
+
+type crawlerScheduleResourceModel struct {
+	Id                 types.Int64    `tfsdk:"id"`
+	Organization       types.String   `tfsdk:"organization"`
+	Project            types.String   `tfsdk:"project"`
+	Crawler            types.String   `tfsdk:"crawler"`
+	Name               types.String   `tfsdk:"name"`
+	ScheduleCronString types.String   `tfsdk:"schedule_cron_string"`
+	ProjectId          types.Int64    `tfsdk:"project_id"`
+	CrawlerConfigId    types.Int64    `tfsdk:"crawler_config_id"`
+	CrawlerLastRunId   types.Int64    `tfsdk:"crawler_last_run_id"`
+	CreatedAt          types.String   `tfsdk:"created_at"`
+	UpdatedAt          types.String   `tfsdk:"updated_at"`
+	Timeouts           timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *crawlerScheduleResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_crawler_schedule"
+}
+
+func (r *crawlerScheduleResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Blocks: timeoutsBlocks(ctx),
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Computed: true,
+			},
+			"organization": organizationAttribute(),
+			"project":      projectAttribute(),
+			"crawler": schema.StringAttribute{
+				MarkdownDescription: "The uuid of the crawler to run",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
+			"schedule_cron_string": schema.StringAttribute{
+				MarkdownDescription: "Standard five field cron expression, eg. `0 3 * * *`",
+				Required:            true,
+				Validators: []validator.String{
+					cronValidator{},
+				},
+			},
+			"project_id": schema.Int64Attribute{
+				Computed: true,
+			},
+			"crawler_config_id": schema.Int64Attribute{
+				Computed: true,
+			},
+			"crawler_last_run_id": schema.Int64Attribute{
+				Computed: true,
+			},
+			"created_at": schema.StringAttribute{
+				Computed: true,
+			},
+			"updated_at": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (r *crawlerScheduleResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyPlanProject(ctx, r.client, req, resp)
+}
+
+func (r *crawlerScheduleResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected resource configure type",
+			fmt.Sprintf("Expected *internal.Client, got: %T. Please report this issue to the provider developers", req.ProviderData),
+		)
+	}
+	r.client = client
+}
+
+func (r *crawlerScheduleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data crawlerScheduleResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := operationContext(ctx, data.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
+	resp.Diagnostics.Append(callCrawlerScheduleCreateAPI(ctx, r, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *crawlerScheduleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data crawlerScheduleResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := operationContext(ctx, data.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
+	resp.Diagnostics.Append(callCrawlerScheduleReadAPI(ctx, r, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The schedule was removed outside of Terraform.
+	if data.Id.IsNull() {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *crawlerScheduleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data crawlerScheduleResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := operationContext(ctx, data.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
+	var state crawlerScheduleResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	data.Id = state.Id
+
+	resp.Diagnostics.Append(callCrawlerScheduleUpdateAPI(ctx, r, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *crawlerScheduleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data crawlerScheduleResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := operationContext(ctx, data.Timeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+
+	resp.Diagnostics.Append(callCrawlerScheduleDeleteAPI(ctx, r, &data)...)
+}
+
+// Import a schedule with project/crawler_uuid/schedule_id.
+func (r *crawlerScheduleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(req.ID, "/")
+	if len(parts) != 3 {
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			"The ID must follow the pattern project/crawler_uuid/schedule_id to import",
+		)
+		return
+	}
+
+	id, err := strconv.ParseInt(parts[2], 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			"The schedule ID must be numeric",
+		)
+		return
+	}
+
+	var data crawlerScheduleResourceModel
+	data.Project = types.StringValue(parts[0])
+	data.Crawler = types.StringValue(parts[1])
+	data.Id = types.Int64Value(id)
+
+	resp.Diagnostics.Append(callCrawlerScheduleReadAPI(ctx, r, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Id.IsNull() {
+		resp.Diagnostics.AddError(
+			"Unable to import crawler schedule",
+			fmt.Sprintf("No crawler schedule matching %s was found.", req.ID),
+		)
+		return
+	}
+
+	// An imported resource has no timeouts block.
+	resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("timeouts"), &data.Timeouts)...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func callCrawlerScheduleCreateAPI(ctx context.Context, r *crawlerScheduleResource, schedule *crawlerScheduleResourceModel) (diags diag.Diagnostics) {
+	org := organizationValue(r.client, schedule.Organization)
+
+	req := client.CrawlerScheduleRequest{
+		ScheduleCronString: schedule.ScheduleCronString.ValueString(),
+	}
+	if !schedule.Name.IsNull() && !schedule.Name.IsUnknown() {
+		req.Name = schedule.Name.ValueString()
+	}
+
+	api, res, err := r.client.CrawlerSchedulesCreate(r.client.RequestContext(ctx), org, schedule.Project.ValueString(), schedule.Crawler.ValueString(), req)
+	if err != nil {
+		diags.Append(apiErrorDiagnostics("Unable to create crawler schedule", res, err, crawlerScheduleAPIFields)...)
+		return
+	}
+
+	schedule.Organization = types.StringValue(org)
+	setCrawlerScheduleModel(schedule, api)
+
+	return
+}
+
+func callCrawlerScheduleReadAPI(ctx context.Context, r *crawlerScheduleResource, schedule *crawlerScheduleResourceModel) (diags diag.Diagnostics) {
+	if schedule.Id.IsNull() || schedule.Id.IsUnknown() {
+		diags.AddAttributeError(
+			path.Root("id"),
+			"Missing crawler_schedule.id attribute",
+			"To read crawler schedule information, id must be provided.",
+		)
+		return
+	}
+
+	org := organizationValue(r.client, schedule.Organization)
+
+	api, res, err := r.client.CrawlerSchedulesRead(r.client.RequestContext(ctx), org, schedule.Project.ValueString(), schedule.Crawler.ValueString(), schedule.Id.ValueInt64())
+	if utils.IsNotFound(res) {
+		// Signal to the caller that the schedule no longer exists.
+		schedule.Id = types.Int64Null()
+		return
+	}
+	if err != nil {
+		diags.Append(apiErrorDiagnostics("Unable to read crawler schedule", res, err, nil)...)
+		return
+	}
+
+	schedule.Organization = types.StringValue(org)
+	setCrawlerScheduleModel(schedule, api)
+
+	return
+}
+
+func callCrawlerScheduleUpdateAPI(ctx context.Context, r *crawlerScheduleResource, schedule *crawlerScheduleResourceModel) (diags diag.Diagnostics) {
+	if schedule.Id.IsNull() || schedule.Id.IsUnknown() {
+		diags.AddAttributeError(
+			path.Root("id"),
+			"Missing crawler_schedule.id attribute",
+			"Unable to update unknown crawler schedule, please update terraform state.",
+		)
+		return
+	}
+
+	org := organizationValue(r.client, schedule.Organization)
+
+	req := client.CrawlerScheduleRequest{
+		ScheduleCronString: schedule.ScheduleCronString.ValueString(),
+	}
+	if !schedule.Name.IsNull() && !schedule.Name.IsUnknown() {
+		req.Name = schedule.Name.ValueString()
+	}
+
+	api, res, err := r.client.CrawlerSchedulesUpdate(r.client.RequestContext(ctx), org, schedule.Project.ValueString(), schedule.Crawler.ValueString(), schedule.Id.ValueInt64(), req)
+	if err != nil {
+		diags.Append(apiErrorDiagnostics("Unable to update crawler schedule", res, err, crawlerScheduleAPIFields)...)
+		return
+	}
+
+	schedule.Organization = types.StringValue(org)
+	setCrawlerScheduleModel(schedule, api)
+
+	return
+}
+
+func callCrawlerScheduleDeleteAPI(ctx context.Context, r *crawlerScheduleResource, schedule *crawlerScheduleResourceModel) (diags diag.Diagnostics) {
+	if schedule.Id.IsNull() || schedule.Id.IsUnknown() {
+		diags.AddAttributeError(
+			path.Root("id"),
+			"Missing crawler_schedule.id attribute",
+			"Unable to delete unknown crawler schedule, please update terraform state.",
+		)
+		return
+	}
+
+	org := organizationValue(r.client, schedule.Organization)
+
+	res, err := r.client.CrawlerSchedulesDelete(r.client.RequestContext(ctx), org, schedule.Project.ValueString(), schedule.Crawler.ValueString(), schedule.Id.ValueInt64())
+	if err != nil && !utils.IsNotFound(res) {
+		diags.Append(apiErrorDiagnostics("Unable to delete crawler schedule", res, err, nil)...)
+	}
+
+	return
+}
+
+// setCrawlerScheduleModel copies the API response into the Terraform model.
+func setCrawlerScheduleModel(schedule *crawlerScheduleResourceModel, api *client.CrawlerSchedule) {
+	schedule.Id = types.Int64Value(api.Id)
+	schedule.Name = types.StringValue(api.Name)
+	// Keep the configured expression when the API only normalised it.
+	if schedule.ScheduleCronString.IsNull() || schedule.ScheduleCronString.IsUnknown() ||
+		utils.NormalizeCron(schedule.ScheduleCronString.ValueString()) != utils.NormalizeCron(api.ScheduleCronString) {
+		schedule.ScheduleCronString = types.StringValue(api.ScheduleCronString)
+	}
+	schedule.ProjectId = types.Int64Value(api.ProjectId)
+	schedule.CrawlerConfigId = types.Int64Value(api.CrawlerConfigId)
+	schedule.CrawlerLastRunId = types.Int64Value(api.CrawlerLastRunId)
+	schedule.CreatedAt = types.StringValue(api.CreatedAt)
+	schedule.UpdatedAt = types.StringValue(api.UpdatedAt)
+}
+
+// cronValidator checks cron expressions at plan time so a typo does not
+// surface as an API error during apply.
+type cronValidator struct{}
+
+func (v cronValidator) Description(ctx context.Context) string {
+	return "value must be a valid five field cron expression"
+}
+
+func (v cronValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v cronValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if err := utils.ValidateCron(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid cron expression",
+			fmt.Sprintf("%q is not a valid cron expression: %s", req.ConfigValue.ValueString(), err.Error()),
+		)
+	}
+}
@@ -0,0 +1,43 @@
+package provider_test
+
+import (
+	"terraform-provider-quant/internal/utils"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateCron(t *testing.T) {
+	valid := []string{
+		"0 3 * * *",
+		"*/15 * * * *",
+		"0 9-17/2 * * MON-FRI",
+		"30 2 1,15 JAN,JUL *",
+		"@daily",
+	}
+	for _, expr := range valid {
+		assert.Nil(t, utils.ValidateCron(expr), expr)
+	}
+
+	invalid := []string{
+		"",
+		"* * * *",
+		"60 * * * *",
+		"0 24 * * *",
+		"0 0 0 * *",
+		"0 0 * 13 *",
+		"*/0 * * * *",
+		"0 17-9 * * *",
+		"0 0 * * FUNDAY",
+	}
+	for _, expr := range invalid {
+		assert.NotNil(t, utils.ValidateCron(expr), expr)
+	}
+}
+
+func TestNormalizeCron(t *testing.T) {
+	assert.Equal(t, "0 3 * * MON-FRI", utils.NormalizeCron("  0  3 *\t* mon-fri "))
+	assert.Equal(t, "0 0 * * *", utils.NormalizeCron("@daily"))
+	assert.Equal(t, utils.NormalizeCron("@Midnight"), utils.NormalizeCron("0 0 * * *"))
+	assert.NotEqual(t, utils.NormalizeCron("0 3 * * *"), utils.NormalizeCron("0 4 * * *"))
+}
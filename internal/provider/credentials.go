@@ -0,0 +1,182 @@
+package provider
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"terraform-provider-quant/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// defaultProfile is read from the credentials file when no profile is chosen.
+const defaultProfile = "default"
+
+const credentialsHint = "Set skip_credentials_validation to true to skip this check."
+
+// validateCredentials reads the configured organization so that a bad token
+// or a misspelt organization fails once at Configure time, rather than as an
+// error from every resource.
+func validateCredentials(ctx context.Context, c *client.Client) (diags diag.Diagnostics) {
+	_, res, err := c.Instance.OrganizationsAPI.OrganizationsRead(c.RequestContext(ctx), c.Organization).Execute()
+	if err == nil || (res != nil && res.StatusCode < 300) {
+		// A body the SDK cannot decode still proves the credentials work.
+		return
+	}
+
+	status, _ := apiErrorDetails(res, err)
+	switch status {
+	case http.StatusUnauthorized:
+		diags.AddAttributeError(
+			path.Root("bearer"),
+			"Invalid QuantCDN API bearer token",
+			"The QuantCDN API rejected the bearer token (HTTP 401). Check that the token is correct and has not been revoked.\n\n"+credentialsHint,
+		)
+	case http.StatusForbidden, http.StatusNotFound:
+		diags.AddAttributeError(
+			path.Root("organization"),
+			"Inaccessible QuantCDN organization",
+			fmt.Sprintf("The organization %q does not exist or the bearer token cannot access it (HTTP %d). "+
+				"Check the organization machine name.\n\n%s", c.Organization, status, credentialsHint),
+		)
+	default:
+		for _, d := range apiErrorDiagnostics("Unable to validate QuantCDN credentials", res, err, nil) {
+			diags.AddError(d.Summary(), d.Detail()+"\n\n"+credentialsHint)
+		}
+	}
+
+	return
+}
+
+// providerCredentials are the settings used to build the API client.
+type providerCredentials struct {
+	Bearer       string
+	Organization string
+	Endpoint     string
+}
+
+// resolveCredentials works out the bearer token, organization and endpoint.
+// Each setting is taken from the first of these that sets it:
+//
+//  1. The provider block.
+//  2. The QUANTCDN_API_TOKEN, QUANTCDN_ORGANIZATION and QUANTCDN_API_ENDPOINT
+//     environment variables.
+//  3. The profile named by the profile attribute or QUANTCDN_PROFILE in the
+//     credentials file, or the "default" profile when none is named.
+//
+// The credentials file is ~/.quant/credentials unless credentials_file or
+// QUANTCDN_CREDENTIALS_FILE say otherwise. A missing file is only an error
+// when a profile or file was chosen explicitly.
+func resolveCredentials(config quantProviderModel, getenv func(string) string) (creds providerCredentials, diags diag.Diagnostics) {
+	file := getenv("QUANTCDN_CREDENTIALS_FILE")
+	if !config.CredentialsFile.IsNull() {
+		file = config.CredentialsFile.ValueString()
+	}
+	profile := getenv("QUANTCDN_PROFILE")
+	if !config.Profile.IsNull() {
+		profile = config.Profile.ValueString()
+	}
+
+	explicit := file != "" || profile != ""
+	if profile == "" {
+		profile = defaultProfile
+	}
+	if file == "" {
+		if home, err := os.UserHomeDir(); err == nil {
+			file = filepath.Join(home, ".quant", "credentials")
+		}
+	}
+
+	if file != "" {
+		profiles, err := readCredentialsFile(file)
+		switch {
+		case err != nil && (explicit || !os.IsNotExist(err)):
+			diags.AddAttributeError(
+				path.Root("credentials_file"),
+				"Unable to read QuantCDN credentials file",
+				fmt.Sprintf("The credentials file %s could not be read: %s", file, err),
+			)
+			return
+		case err == nil:
+			values, ok := profiles[profile]
+			if !ok && explicit {
+				diags.AddAttributeError(
+					path.Root("profile"),
+					"Missing QuantCDN credentials profile",
+					fmt.Sprintf("The profile %q was not found in the credentials file %s.", profile, file),
+				)
+				return
+			}
+			creds = providerCredentials{
+				Bearer:       values["bearer"],
+				Organization: values["organization"],
+				Endpoint:     values["endpoint"],
+			}
+		}
+	}
+
+	for _, setting := range []struct {
+		value types.String
+		env   string
+		out   *string
+	}{
+		{config.Bearer, "QUANTCDN_API_TOKEN", &creds.Bearer},
+		{config.Organization, "QUANTCDN_ORGANIZATION", &creds.Organization},
+		{config.Endpoint, "QUANTCDN_API_ENDPOINT", &creds.Endpoint},
+	} {
+		if !setting.value.IsNull() {
+			*setting.out = setting.value.ValueString()
+		} else if v := getenv(setting.env); v != "" {
+			*setting.out = v
+		}
+	}
+
+	return
+}
+
+// readCredentialsFile parses an INI style credentials file into its profiles:
+//
+//	[default]
+//	bearer       = ...
+//	organization = my-org
+//
+// Blank lines and lines starting with # or ; are ignored.
+func readCredentialsFile(name string) (map[string]map[string]string, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	profiles := map[string]map[string]string{}
+	var current map[string]string
+
+	scanner := bufio.NewScanner(f)
+	for n := 1; scanner.Scan(); n++ {
+		line := strings.TrimSpace(scanner.Text())
+		switch {
+		case line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, ";"):
+			continue
+		case strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]"):
+			name := strings.TrimSpace(line[1 : len(line)-1])
+			if profiles[name] == nil {
+				profiles[name] = map[string]string{}
+			}
+			current = profiles[name]
+		default:
+			key, value, ok := strings.Cut(line, "=")
+			if !ok || current == nil {
+				return nil, fmt.Errorf("line %d: expected a [profile] header or a key = value pair", n)
+			}
+			current[strings.TrimSpace(key)] = strings.Trim(strings.TrimSpace(value), `"`)
+		}
+	}
+
+	return profiles, scanner.Err()
+}
@@ -0,0 +1,104 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"terraform-provider-quant/internal/client"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateCredentials(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Header.Get("Authorization") != "Bearer good":
+			w.WriteHeader(http.StatusUnauthorized)
+			w.Write([]byte(`{"message":"Unauthenticated."}`))
+		case r.URL.Path == "/organizations/quant":
+			w.Write([]byte(`{"name":"Quant","organizations":"quant","region":"au"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"message":"Not found."}`))
+		}
+	}))
+	defer srv.Close()
+
+	validate := func(bearer, organization string) diag.Diagnostics {
+		c := client.New(context.Background(), bearer, organization, client.Options{Endpoint: srv.URL, MaxRetries: 0})
+		return validateCredentials(context.Background(), c)
+	}
+
+	assert.False(t, validate("good", "quant").HasError())
+
+	diags := validate("bad", "quant")
+	assert.Equal(t, 1, diags.ErrorsCount())
+	assert.Equal(t, path.Root("bearer"), diags[0].(diag.DiagnosticWithPath).Path())
+
+	diags = validate("good", "quant-typo")
+	assert.Equal(t, 1, diags.ErrorsCount())
+	assert.Equal(t, path.Root("organization"), diags[0].(diag.DiagnosticWithPath).Path())
+	assert.True(t, strings.Contains(diags[0].Detail(), `"quant-typo"`), diags[0].Detail())
+}
+
+func TestResolveCredentials(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "credentials")
+	assert.Nil(t, os.WriteFile(file, []byte(`
+# Shared QuantCDN credentials
+[default]
+bearer       = default-token
+organization = default-org
+
+[staging]
+bearer       = "staging-token"
+organization = staging-org
+endpoint     = https://staging.example.com/api/v2
+`), 0600))
+
+	env := func(vars map[string]string) func(string) string {
+		return func(key string) string { return vars[key] }
+	}
+
+	// The default profile is read when no profile is named.
+	creds, diags := resolveCredentials(quantProviderModel{}, env(map[string]string{"QUANTCDN_CREDENTIALS_FILE": file}))
+	assert.False(t, diags.HasError())
+	assert.Equal(t, providerCredentials{Bearer: "default-token", Organization: "default-org"}, creds)
+
+	// QUANTCDN_PROFILE selects a profile, the profile attribute overrides it.
+	creds, _ = resolveCredentials(quantProviderModel{}, env(map[string]string{"QUANTCDN_CREDENTIALS_FILE": file, "QUANTCDN_PROFILE": "staging"}))
+	assert.Equal(t, "staging-token", creds.Bearer)
+	assert.Equal(t, "https://staging.example.com/api/v2", creds.Endpoint)
+
+	creds, _ = resolveCredentials(quantProviderModel{Profile: types.StringValue("default")}, env(map[string]string{"QUANTCDN_CREDENTIALS_FILE": file, "QUANTCDN_PROFILE": "staging"}))
+	assert.Equal(t, "default-token", creds.Bearer)
+
+	// Environment variables override the profile, the provider block
+	// overrides both.
+	creds, _ = resolveCredentials(
+		quantProviderModel{CredentialsFile: types.StringValue(file), Profile: types.StringValue("staging"), Organization: types.StringValue("block-org")},
+		env(map[string]string{"QUANTCDN_API_TOKEN": "env-token", "QUANTCDN_ORGANIZATION": "env-org"}),
+	)
+	assert.Equal(t, providerCredentials{Bearer: "env-token", Organization: "block-org", Endpoint: "https://staging.example.com/api/v2"}, creds)
+
+	// A profile that was asked for must exist.
+	_, diags = resolveCredentials(quantProviderModel{Profile: types.StringValue("production")}, env(map[string]string{"QUANTCDN_CREDENTIALS_FILE": file}))
+	assert.Equal(t, 1, diags.ErrorsCount())
+	assert.Equal(t, path.Root("profile"), diags[0].(diag.DiagnosticWithPath).Path())
+
+	// A missing file is fine unless it was asked for.
+	missing := filepath.Join(t.TempDir(), "credentials")
+	_, diags = resolveCredentials(quantProviderModel{CredentialsFile: types.StringValue(missing)}, env(nil))
+	assert.Equal(t, 1, diags.ErrorsCount())
+
+	t.Setenv("HOME", t.TempDir())
+	creds, diags = resolveCredentials(quantProviderModel{}, env(map[string]string{"QUANTCDN_API_TOKEN": "env-token"}))
+	assert.False(t, diags.HasError())
+	assert.Equal(t, providerCredentials{Bearer: "env-token"}, creds)
+}
@@ -0,0 +1,64 @@
+package provider
+
+import (
+	"context"
+	"terraform-provider-quant/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// projectAttribute is the project attribute of resources that belong to a
+// project. Resources that do not set it use the provider default_project,
+// see modifyPlanProject, and moving them to another project replaces them.
+func projectAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		MarkdownDescription: "Project machine name, defaults to the provider `default_project`",
+		Optional:            true,
+		Computed:            true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+			stringplanmodifier.RequiresReplace(),
+		},
+	}
+}
+
+// modifyPlanProject plans the provider default project for a new resource
+// that does not set project, or fails the plan when there is no default
+// either. Without it the missing project would only surface as an API error
+// during apply.
+func modifyPlanProject(ctx context.Context, c *client.Client, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// The resource is being destroyed.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var configured, planned types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("project"), &configured)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("project"), &planned)...)
+	if resp.Diagnostics.HasError() || !configured.IsNull() || !planned.IsUnknown() {
+		return
+	}
+
+	// The provider is not configured yet when its configuration depends on
+	// values that are only known after apply.
+	if c == nil {
+		return
+	}
+
+	if c.Project == "" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("project"),
+			"Missing project",
+			"The project must be set on the resource, or a default project with default_project in the provider "+
+				"block or the QUANTCDN_PROJECT environment variable.",
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("project"), c.Project)...)
+}
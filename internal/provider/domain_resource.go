@@ -4,21 +4,33 @@ import (
 	"context"
 	"fmt"
 	"strconv"
+	"strings"
 	"terraform-provider-quant/internal/client"
 	"terraform-provider-quant/internal/resource_domain"
+	"terraform-provider-quant/internal/utils"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	openapi "github.com/quantcdn/quant-admin-go"
 )
 
 var (
-	_ resource.Resource              = (*domainResource)(nil)
-	_ resource.ResourceWithConfigure = (*domainResource)(nil)
+	_ resource.Resource                = (*domainResource)(nil)
+	_ resource.ResourceWithConfigure   = (*domainResource)(nil)
+	_ resource.ResourceWithImportState = (*domainResource)(nil)
+	_ resource.ResourceWithModifyPlan  = (*domainResource)(nil)
 )
 
+// domainAPIFields maps domain request fields to their attributes.
+var domainAPIFields = apiFields{
+	"name":   "name",
+	"domain": "domain",
+}
+
 func NewDomainResource() resource.Resource {
 	return &domainResource{}
 }
@@ -27,12 +39,29 @@ type domainResource struct {
 	client *client.Client
 }
 
+// domainResourceModel adds the timeouts block to the generated model.
+type domainResourceModel struct {
+	resource_domain.DomainModel
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
 func (r *domainResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_domain"
 }
 
 func (r *domainResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = resource_domain.DomainResourceSchema(ctx)
+	resp.Schema.Attributes["organization"] = organizationAttribute()
+	resp.Schema.Attributes["name"] = schema.StringAttribute{
+		Required:    true,
+		Description: "A label for the domain. The API does not return it, so an imported domain has no name until the next apply sets it.",
+	}
+	resp.Schema.Blocks = timeoutsBlocks(ctx)
+	resp.Schema.Attributes["project"] = projectAttribute()
+}
+
+func (r *domainResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyPlanProject(ctx, r.client, req, resp)
 }
 
 func (r *domainResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -50,7 +79,7 @@ func (r *domainResource) Configure(_ context.Context, req resource.ConfigureRequ
 }
 
 func (r *domainResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var data resource_domain.DomainModel
+	var data domainResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -59,8 +88,11 @@ func (r *domainResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
+	ctx, cancel := operationContext(ctx, data.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
 	// Create API call logic
-	resp.Diagnostics.Append(callDomainCreateAPI(ctx, r, &data)...)
+	resp.Diagnostics.Append(callDomainCreateAPI(ctx, r, &data.DomainModel)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -70,7 +102,7 @@ func (r *domainResource) Create(ctx context.Context, req resource.CreateRequest,
 }
 
 func (r *domainResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var data resource_domain.DomainModel
+	var data domainResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -79,17 +111,26 @@ func (r *domainResource) Read(ctx context.Context, req resource.ReadRequest, res
 		return
 	}
 
-	resp.Diagnostics.Append(callDomainCreateAPI(ctx, r, &data)...)
+	ctx, cancel := operationContext(ctx, data.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
+	resp.Diagnostics.Append(callDomainReadAPI(ctx, r, &data.DomainModel)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	// The domain was removed outside of Terraform.
+	if data.Id.IsNull() {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *domainResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data resource_domain.DomainModel
+	var data domainResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -98,7 +139,14 @@ func (r *domainResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
-	resp.Diagnostics.Append(callDomainUpdateAPI(ctx, r, &data)...)
+	ctx, cancel := operationContext(ctx, data.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
+	var state domainResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	data.Id = state.Id
+
+	resp.Diagnostics.Append(callDomainUpdateAPI(ctx, r, &data.DomainModel)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -108,7 +156,7 @@ func (r *domainResource) Update(ctx context.Context, req resource.UpdateRequest,
 }
 
 func (r *domainResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data resource_domain.DomainModel
+	var data domainResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -117,30 +165,86 @@ func (r *domainResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
-	resp.Diagnostics.Append(callDomainDeleteAPI(ctx, r, &data)...)
+	ctx, cancel := operationContext(ctx, data.Timeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+
+	resp.Diagnostics.Append(callDomainDeleteAPI(ctx, r, &data.DomainModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Import a domain with either project/domain_id or project/hostname.
+func (r *domainResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	var data domainResourceModel
+	var hostname types.String
+	var err error
+	data.Project, data.Id, hostname, err = utils.GetDomainImportId(req.ID)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			err.Error(),
+		)
+		return
+	}
+
+	data.Organization = types.StringNull()
+
+	if data.Id.IsNull() {
+		resp.Diagnostics.Append(callDomainLookupAPI(ctx, r, &data.DomainModel, hostname.ValueString())...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(callDomainReadAPI(ctx, r, &data.DomainModel)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	if data.Id.IsNull() {
+		resp.Diagnostics.AddError(
+			"Unable to import domain",
+			fmt.Sprintf("No domain matching %s was found.", req.ID),
+		)
+		return
+	}
+
+	// The domain name is not returned by the API, it is set by the next apply.
+	data.Name = types.StringNull()
+
+	// An imported resource has no timeouts block.
+	resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("timeouts"), &data.Timeouts)...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func callDomainCreateAPI(ctx context.Context, r *domainResource, domain *resource_domain.DomainModel) (diags diag.Diagnostics) {
 	req := *openapi.NewDomainRequestWithDefaults()
 
+	if domain.Project.IsNull() || domain.Project.IsUnknown() {
+		diags.AddAttributeError(
+			path.Root("project"),
+			"Missing project attribute",
+			"Unable to add the domain because of a missing project",
+		)
+		return
+	}
+
+	req.Name = domain.Name.ValueString()
 	req.Domain = domain.Domain.ValueString()
 
-	org := r.client.Organization
-	if !domain.Organization.IsNull() {
-		org = domain.Organization.ValueString()
-	}
+	org := organizationValue(r.client, domain.Organization)
 
-	api, _, err := r.client.Instance.DomainsAPI.DomainsCreate(r.client.AuthContext, org, domain.Project.ValueString()).DomainRequest(req).Execute()
+	api, res, err := r.client.Instance.DomainsAPI.DomainsCreate(r.client.RequestContext(ctx), org, domain.Project.ValueString()).DomainRequest(req).Execute()
 	if err != nil {
-		diags.AddError("Unable to add domain", fmt.Sprintf("Error: %s", err.Error()))
+		diags.Append(apiErrorDiagnostics("Unable to add domain", res, err, domainAPIFields)...)
+		return
 	}
 
-	domain.Id = types.Int64Value(int64(api.GetId()))
-	domain.CreatedAt = types.StringValue(api.GetCreatedAt())
-	domain.DnsEngaged = types.Int64Value(int64(api.GetDnsEngaged()))
+	domain.Organization = types.StringValue(org)
+	setDomainModel(domain, api)
 
 	return
 }
@@ -164,20 +268,22 @@ func callDomainUpdateAPI(ctx context.Context, r *domainResource, domain *resourc
 		return
 	}
 
-	org := r.client.Organization
-	if !domain.Organization.IsNull() {
-		org = domain.Organization.ValueString()
-	}
+	org := organizationValue(r.client, domain.Organization)
+
+	req := *openapi.NewDomainRequestUpdateWithDefaults()
+	req.SetName(domain.Name.ValueString())
+	req.SetDomain(domain.Domain.ValueString())
 
 	id := strconv.Itoa(int(domain.Id.ValueInt64()))
-	api, _, err := r.client.Instance.DomainsAPI.DomainsUpdate(r.client.AuthContext, org, domain.Project.ValueString(), id).Execute()
+	api, res, err := r.client.Instance.DomainsAPI.DomainsUpdate(r.client.RequestContext(ctx), org, domain.Project.ValueString(), id).DomainRequestUpdate(req).Execute()
 
 	if err != nil {
-		diags.AddError("Unable to update domain", fmt.Sprintf("Error: %s", err.Error()))
+		diags.Append(apiErrorDiagnostics("Unable to update domain", res, err, domainAPIFields)...)
 		return
 	}
 
-	domain.UpdatedAt = types.StringValue(api.GetUpdatedAt())
+	domain.Organization = types.StringValue(org)
+	setDomainModel(domain, api)
 
 	return
 }
@@ -187,7 +293,7 @@ func callDomainReadAPI(ctx context.Context, r *domainResource, domain *resource_
 		diags.AddAttributeError(
 			path.Root("id"),
 			"Missing ID attribute",
-			"Unable to update the domain because of missing ID",
+			"Unable to read the domain because of missing ID",
 		)
 		return
 	}
@@ -196,31 +302,67 @@ func callDomainReadAPI(ctx context.Context, r *domainResource, domain *resource_
 		diags.AddAttributeError(
 			path.Root("project"),
 			"Missing project attribute",
-			"Unable to update the domain because of a missing project",
+			"Unable to read the domain because of a missing project",
 		)
 		return
 	}
 
-	org := r.client.Organization
-	if !domain.Organization.IsNull() {
-		org = domain.Organization.ValueString()
-	}
+	org := organizationValue(r.client, domain.Organization)
 
 	id := strconv.Itoa(int(domain.Id.ValueInt64()))
 
-	api, _, err := r.client.Instance.DomainsAPI.DomainsRead(r.client.AuthContext, org, domain.Project.ValueString(), id).Execute()
+	api, res, err := r.client.Instance.DomainsAPI.DomainsRead(r.client.RequestContext(ctx), org, domain.Project.ValueString(), id).Execute()
+	if utils.IsNotFound(res) {
+		// Signal to the caller that the domain no longer exists.
+		domain.Id = types.Int64Null()
+		return
+	}
 	if err != nil {
-		diags.AddError("Unable to read domain", fmt.Sprintf("Error: %s", err.Error()))
+		diags.Append(apiErrorDiagnostics("Unable to read domain", res, err, nil)...)
+		return
 	}
 
+	domain.Organization = types.StringValue(org)
+	setDomainModel(domain, api)
+
+	return
+}
+
+// callDomainLookupAPI finds the domain ID for a hostname in the project.
+func callDomainLookupAPI(ctx context.Context, r *domainResource, domain *resource_domain.DomainModel, hostname string) (diags diag.Diagnostics) {
+	org := organizationValue(r.client, domain.Organization)
+
+	domains, res, err := r.client.Instance.DomainsAPI.DomainsList(r.client.RequestContext(ctx), org, domain.Project.ValueString()).Execute()
+	if err != nil {
+		diags.Append(apiErrorDiagnostics("Unable to list domains", res, err, nil)...)
+		return
+	}
+
+	for _, d := range domains {
+		if strings.EqualFold(d.GetDomain(), hostname) {
+			domain.Id = types.Int64Value(int64(d.GetId()))
+			return
+		}
+	}
+
+	diags.AddError(
+		"Unable to import domain",
+		fmt.Sprintf("The domain %s is not attached to project %s.", hostname, domain.Project.ValueString()),
+	)
+	return
+}
+
+// setDomainModel copies the API response into the Terraform model.
+func setDomainModel(domain *resource_domain.DomainModel, api *openapi.Domain) {
 	domain.Id = types.Int64Value(int64(api.GetId()))
 	domain.Domain = types.StringValue(api.GetDomain())
+	domain.ProjectId = types.Int64Value(int64(api.GetProjectId()))
+	domain.DnsEngaged = types.Int64Value(int64(api.GetDnsEngaged()))
+	domain.InSection = types.Int64Value(int64(api.GetInSection()))
+	domain.SectionMessage = types.StringValue(api.GetSectionMessage())
 	domain.CreatedAt = types.StringValue(api.GetCreatedAt())
 	domain.UpdatedAt = types.StringValue(api.GetUpdatedAt())
-
-	domain.DnsEngaged = types.Int64Value(int64(api.GetDnsEngaged()))
-
-	return
+	domain.DeletedAt = types.StringPointerValue(api.DeletedAt)
 }
 
 func callDomainDeleteAPI(ctx context.Context, r *domainResource, domain *resource_domain.DomainModel) (diags diag.Diagnostics) {
@@ -228,7 +370,7 @@ func callDomainDeleteAPI(ctx context.Context, r *domainResource, domain *resourc
 		diags.AddAttributeError(
 			path.Root("id"),
 			"Missing ID attribute",
-			"Unable to update the domain because of missing ID",
+			"Unable to delete the domain because of missing ID",
 		)
 		return
 	}
@@ -237,22 +379,19 @@ func callDomainDeleteAPI(ctx context.Context, r *domainResource, domain *resourc
 		diags.AddAttributeError(
 			path.Root("project"),
 			"Missing project attribute",
-			"Unable to update the domain because of a missing project",
+			"Unable to delete the domain because of a missing project",
 		)
 		return
 	}
 
-	org := r.client.Organization
-	if !domain.Organization.IsNull() {
-		org = domain.Organization.ValueString()
-	}
+	org := organizationValue(r.client, domain.Organization)
 
 	id := strconv.Itoa(int(domain.Id.ValueInt64()))
 
-	_, _, err := r.client.Instance.DomainsAPI.DomainsDelete(r.client.AuthContext, org, domain.Project.ValueString(), id).Execute()
+	_, res, err := r.client.Instance.DomainsAPI.DomainsDelete(r.client.RequestContext(ctx), org, domain.Project.ValueString(), id).Execute()
 
-	if err != nil {
-		diags.AddError("Unable to delete project", fmt.Sprintf("Error: %s", err.Error()))
+	if err != nil && !utils.IsNotFound(res) {
+		diags.Append(apiErrorDiagnostics("Unable to delete domain", res, err, nil)...)
 		return
 	}
 
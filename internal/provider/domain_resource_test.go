@@ -2,9 +2,13 @@ package provider_test
 
 import (
 	"context"
+	"fmt"
 	"os"
+	"terraform-provider-quant/internal/utils"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	openapi "github.com/quantcdn/quant-admin-go"
 	"github.com/stretchr/testify/assert"
 )
@@ -25,3 +29,73 @@ func TestListDomains(t *testing.T) {
 		assert.Contains(t, domain.GetDomain(), "quantcdn")
 	}
 }
+
+func TestDomainImportId(t *testing.T) {
+	project, id, hostname, err := utils.GetDomainImportId("api-test/42")
+	assert.Nil(t, err)
+	assert.Equal(t, "api-test", project.ValueString())
+	assert.Equal(t, int64(42), id.ValueInt64())
+	assert.True(t, hostname.IsNull())
+
+	project, id, hostname, err = utils.GetDomainImportId("api-test/WWW.Example.com")
+	assert.Nil(t, err)
+	assert.Equal(t, "api-test", project.ValueString())
+	assert.True(t, id.IsNull())
+	assert.Equal(t, "WWW.Example.com", hostname.ValueString(), "hostnames are matched case-insensitively on lookup")
+
+	_, _, _, err = utils.GetDomainImportId("www.example.com")
+	assert.NotNil(t, err)
+}
+
+func TestAccDomainResource(t *testing.T) {
+	api := newFakeAPI(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDestroyed(api, "domains"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(api) + `
+resource "quant_domain" "test" {
+  project = "api-test"
+  domain  = "www.example.com"
+  name    = "www"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("quant_domain.test", "id"),
+					resource.TestCheckResourceAttr("quant_domain.test", "domain", "www.example.com"),
+				),
+			},
+			{
+				Config: testAccProviderConfig(api) + `
+resource "quant_domain" "test" {
+  project = "api-test"
+  domain  = "www.example.com"
+  name    = "main site"
+}
+`,
+				Check: resource.TestCheckResourceAttr("quant_domain.test", "name", "main site"),
+			},
+			{
+				ResourceName:      "quant_domain.test",
+				ImportState:       true,
+				ImportStateIdFunc: testAccDomainImportId("quant_domain.test"),
+				ImportStateVerify: true,
+				// The API does not return the name.
+				ImportStateVerifyIgnore: []string{"name"},
+			},
+		},
+	})
+}
+
+// testAccDomainImportId builds the project/id import ID of a domain.
+func testAccDomainImportId(name string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return "", fmt.Errorf("resource %s not found in state", name)
+		}
+		return rs.Primary.Attributes["project"] + "/" + rs.Primary.Attributes["id"], nil
+	}
+}
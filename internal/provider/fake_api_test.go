@@ -0,0 +1,310 @@
+package provider_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"sync"
+	"terraform-provider-quant/internal/client"
+	"terraform-provider-quant/internal/utils"
+	"testing"
+	"time"
+
+	openapi "github.com/quantcdn/quant-admin-go"
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeAPI is an in-memory stand in for the QuantCDN Admin API. It covers the
+// projects, custom headers, domains, crawlers and rules endpoints closely
+// enough for acceptance tests to run offline against it.
+type fakeAPI struct {
+	*httptest.Server
+
+	mu      sync.Mutex
+	nextId  int64
+	objects map[string][]map[string]interface{}
+	headers map[string]map[string]string
+
+	// Organizations that requests were sent to.
+	orgs map[string]bool
+}
+
+var (
+	fakeOrgPath        = regexp.MustCompile(`^/organizations/([^/]+)$`)
+	fakeProjectsPath   = regexp.MustCompile(`^/organizations/[^/]+/projects$`)
+	fakeProjectPath    = regexp.MustCompile(`^/organizations/[^/]+/projects/([^/]+)$`)
+	fakeHeadersPath    = regexp.MustCompile(`^/organizations/[^/]+/projects/([^/]+)/custom-headers$`)
+	fakeCollectionPath = regexp.MustCompile(`^/organizations/[^/]+/projects/([^/]+)/(domains|crawlers|rules/[^/]+)$`)
+	fakeItemPath       = regexp.MustCompile(`^/organizations/[^/]+/projects/([^/]+)/(domains|crawlers|rules/[^/]+)/([^/]+)$`)
+)
+
+// Rule fields that stay at the top level of a rule, everything else sent
+// with a rule is returned in action_config.
+var fakeRuleCriteria = map[string]bool{
+	"name": true, "url": true, "domain": true, "disabled": true, "only_with_cookie": true, "cookie_name": true,
+	"method": true, "method_is": true, "method_is_not": true,
+	"ip": true, "ip_is": true, "ip_is_not": true,
+	"country": true, "country_is": true, "country_is_not": true,
+}
+
+func newFakeAPI(t *testing.T) *fakeAPI {
+	f := &fakeAPI{
+		objects: map[string][]map[string]interface{}{},
+		headers: map[string]map[string]string{},
+		orgs:    map[string]bool{},
+	}
+	f.Server = httptest.NewServer(http.HandlerFunc(f.serve))
+	t.Cleanup(f.Close)
+	return f
+}
+
+func (f *fakeAPI) serve(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if r.Header.Get("Authorization") != "Bearer test-token" {
+		f.write(w, http.StatusUnauthorized, map[string]string{"message": "Unauthenticated."})
+		return
+	}
+
+	var body map[string]interface{}
+	if r.Body != nil && r.ContentLength != 0 {
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			f.write(w, http.StatusBadRequest, map[string]string{"message": err.Error()})
+			return
+		}
+	}
+
+	p := r.URL.Path
+	if parts := strings.Split(p, "/"); len(parts) > 2 && parts[1] == "organizations" {
+		f.orgs[parts[2]] = true
+	}
+
+	switch {
+	case fakeOrgPath.MatchString(p):
+		if org := fakeOrgPath.FindStringSubmatch(p)[1]; org == "quant" && r.Method == http.MethodGet {
+			f.write(w, http.StatusOK, map[string]string{"name": "Quant", "organizations": org, "region": "au"})
+		} else {
+			f.write(w, http.StatusNotFound, map[string]string{"message": "Not found."})
+		}
+	case fakeProjectsPath.MatchString(p):
+		f.collection(w, r, "projects", "machine_name", body)
+	case fakeProjectPath.MatchString(p):
+		f.item(w, r, "projects", "machine_name", fakeProjectPath.FindStringSubmatch(p)[1], body)
+	case fakeHeadersPath.MatchString(p):
+		f.customHeaders(w, r, fakeHeadersPath.FindStringSubmatch(p)[1], body)
+	case fakeCollectionPath.MatchString(p):
+		m := fakeCollectionPath.FindStringSubmatch(p)
+		f.collection(w, r, m[1]+"/"+m[2], fakeKey(m[2]), body)
+	case fakeItemPath.MatchString(p):
+		m := fakeItemPath.FindStringSubmatch(p)
+		f.item(w, r, m[1]+"/"+m[2], fakeKey(m[2]), m[3], body)
+	default:
+		f.write(w, http.StatusNotFound, map[string]string{"message": "Not found."})
+	}
+}
+
+func fakeKey(kind string) string {
+	if kind == "domains" {
+		return "id"
+	}
+	return "uuid"
+}
+
+func (f *fakeAPI) collection(w http.ResponseWriter, r *http.Request, collection string, key string, body map[string]interface{}) {
+	switch r.Method {
+	case http.MethodGet:
+		list := f.objects[collection]
+		if list == nil {
+			list = []map[string]interface{}{}
+		}
+		f.write(w, http.StatusOK, list)
+	case http.MethodPost:
+		obj := f.newObject(collection, body)
+		if key == "machine_name" {
+			for _, o := range f.objects[collection] {
+				if o[key] == obj[key] {
+					f.write(w, http.StatusUnprocessableEntity, map[string]interface{}{
+						"message": "The given data was invalid.",
+						"errors":  map[string][]string{"name": {"The name has already been taken."}},
+					})
+					return
+				}
+			}
+		}
+		f.objects[collection] = append(f.objects[collection], obj)
+		f.write(w, http.StatusOK, obj)
+	default:
+		f.write(w, http.StatusMethodNotAllowed, map[string]string{"message": "Method not allowed."})
+	}
+}
+
+func (f *fakeAPI) item(w http.ResponseWriter, r *http.Request, collection string, key string, id string, body map[string]interface{}) {
+	list := f.objects[collection]
+	for i, obj := range list {
+		if fmt.Sprint(obj[key]) != id {
+			continue
+		}
+
+		switch r.Method {
+		case http.MethodGet:
+			f.write(w, http.StatusOK, obj)
+		case http.MethodPatch:
+			f.update(collection, obj, body)
+			f.write(w, http.StatusOK, obj)
+		case http.MethodDelete:
+			f.objects[collection] = append(list[:i], list[i+1:]...)
+			f.write(w, http.StatusOK, obj)
+		default:
+			f.write(w, http.StatusMethodNotAllowed, map[string]string{"message": "Method not allowed."})
+		}
+		return
+	}
+
+	f.write(w, http.StatusNotFound, map[string]string{"message": "Not found."})
+}
+
+func (f *fakeAPI) customHeaders(w http.ResponseWriter, r *http.Request, project string, body map[string]interface{}) {
+	headers := f.headers[project]
+	if headers == nil {
+		headers = map[string]string{}
+	}
+
+	switch r.Method {
+	case http.MethodPost:
+		values, _ := body["headers"].(map[string]interface{})
+		headers = map[string]string{}
+		for k, v := range values {
+			headers[k] = fmt.Sprint(v)
+		}
+	case http.MethodDelete:
+		names, _ := body["headers"].([]interface{})
+		for _, n := range names {
+			delete(headers, fmt.Sprint(n))
+		}
+	}
+
+	f.headers[project] = headers
+	f.write(w, http.StatusOK, headers)
+}
+
+// newObject builds the stored object for a create request the way the API
+// shapes its responses.
+func (f *fakeAPI) newObject(collection string, body map[string]interface{}) map[string]interface{} {
+	f.nextId++
+	now := time.Now().UTC().Format(time.RFC3339)
+	uuid := fmt.Sprintf("00000000-0000-4000-8000-%012d", f.nextId)
+
+	obj := map[string]interface{}{
+		"id":         f.nextId,
+		"uuid":       uuid,
+		"created_at": now,
+		"updated_at": now,
+	}
+
+	switch {
+	case collection == "projects":
+		obj["machine_name"] = strings.ReplaceAll(strings.ToLower(fmt.Sprint(body["name"])), " ", "-")
+		obj["organization_id"] = 1
+	case strings.HasSuffix(collection, "/domains"):
+		obj["dns_engaged"] = 0
+	case strings.HasSuffix(collection, "/crawlers"):
+		obj["project_id"] = 1
+		obj["config"] = "{}"
+	case strings.Contains(collection, "/rules/"):
+		obj["rule_id"] = uuid
+		obj["disabled"] = false
+		obj["action"] = collection[strings.LastIndex(collection, "/")+1:]
+		obj["action_config"] = map[string]interface{}{}
+	}
+
+	f.update(collection, obj, body)
+	return obj
+}
+
+func (f *fakeAPI) update(collection string, obj map[string]interface{}, body map[string]interface{}) {
+	obj["updated_at"] = time.Now().UTC().Format(time.RFC3339)
+
+	switch {
+	case strings.Contains(collection, "/rules/"):
+		action := obj["action_config"].(map[string]interface{})
+		for k, v := range body {
+			switch {
+			case k == "only_with_cookie":
+				// The API returns the flag as a string.
+				obj[k] = fmt.Sprint(v)
+			case fakeRuleCriteria[k]:
+				obj[k] = v
+			case k == "redirect_to":
+				action["to"] = v
+			case k == "redirect_code" || k == "status_code":
+				// The API returns status codes as strings.
+				action["status_code"] = fmt.Sprint(v)
+			case k == "auth_pass":
+				// The API never returns the password.
+			default:
+				action[k] = v
+			}
+		}
+	case strings.HasSuffix(collection, "/crawlers"):
+		config := map[string]interface{}{}
+		_ = json.Unmarshal([]byte(fmt.Sprint(obj["config"])), &config)
+		for k, v := range body {
+			switch k {
+			case "browser_mode", "url_list", "headers":
+				config[k] = v
+			default:
+				obj[k] = v
+			}
+		}
+		b, _ := json.Marshal(config)
+		obj["config"] = string(b)
+	default:
+		for k, v := range body {
+			if k != "id" && k != "uuid" {
+				obj[k] = v
+			}
+		}
+	}
+}
+
+func (f *fakeAPI) write(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func TestFakeAPI(t *testing.T) {
+	api := newFakeAPI(t)
+	c := client.New(context.Background(), "test-token", "quant", client.Options{Endpoint: api.URL})
+
+	req := *openapi.NewProjectRequestWithDefaults()
+	req.SetName("API Test")
+	req.SetRegion("au")
+	project, _, err := c.Instance.ProjectsAPI.ProjectsCreate(c.AuthContext, "quant").ProjectRequest(req).Execute()
+	assert.Nil(t, err)
+	assert.Equal(t, "api-test", project.GetMachineName())
+
+	_, _, err = c.Instance.ProjectsAPI.ProjectsRead(c.AuthContext, "quant", "api-test").Execute()
+	assert.Nil(t, err)
+
+	rule, _, err := c.RulesCreate(c.AuthContext, "quant", "api-test", "content", client.RuleRequest{Name: "landing", Action: map[string]interface{}{"to": "/landing-b"}})
+	assert.Nil(t, err)
+
+	var action struct {
+		To string `json:"to"`
+	}
+	assert.Nil(t, rule.DecodeActionConfig(&action))
+	assert.Equal(t, "/landing-b", action.To)
+
+	_, err = c.RulesDelete(c.AuthContext, "quant", "api-test", "content", rule.GetUuid())
+	assert.Nil(t, err)
+
+	_, res, err := c.RulesRead(c.AuthContext, "quant", "api-test", "content", rule.GetUuid())
+	assert.NotNil(t, err)
+	assert.True(t, utils.IsNotFound(res))
+}
@@ -8,9 +8,12 @@ import (
 	"sort"
 	"strings"
 	"terraform-provider-quant/internal/client"
+	"terraform-provider-quant/internal/utils"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -21,8 +24,14 @@ var (
 	_ resource.Resource              = (*headerResource)(nil)
 	_ resource.ResourceWithConfigure = (*headerResource)(nil)
 	_ resource.ResourceWithImportState = (*headerResource)(nil)
+	_ resource.ResourceWithModifyPlan = (*headerResource)(nil)
 )
 
+// headerAPIFields maps custom header request fields to their attributes.
+var headerAPIFields = apiFields{
+	"headers": "headers",
+}
+
 
 func NewHeaderResource() resource.Resource {
 	return &headerResource{}
@@ -36,6 +45,8 @@ type headerResourceModel struct {
 	Id types.String `tfsdk:"id"`
 	Headers types.Map `tfsdk:"headers"`
 	Project types.String `tfsdk:"project"`
+	Organization types.String `tfsdk:"organization"`
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *headerResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -44,13 +55,13 @@ func (r *headerResource) Metadata(ctx context.Context, req resource.MetadataRequ
 
 func (r *headerResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Blocks: timeoutsBlocks(ctx),
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Computed: true,
 			},
-			"project": schema.StringAttribute{
-				Required: true,
-			},
+			"organization": organizationAttribute(),
+			"project": projectAttribute(),
 			"headers": schema.MapAttribute{
 				ElementType: types.StringType,
 				Required: true,
@@ -60,6 +71,10 @@ func (r *headerResource) Schema(ctx context.Context, req resource.SchemaRequest,
 	}
 }
 
+func (r *headerResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyPlanProject(ctx, r.client, req, resp)
+}
+
 func (r *headerResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -86,9 +101,16 @@ func (r *headerResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
+	ctx, cancel := operationContext(ctx, data.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
 	// Create API call logic
 	resp.Diagnostics.Append(callHeaderCreateUpdateAPI(ctx, r, &data)...)
 
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -103,9 +125,22 @@ func (r *headerResource) Read(ctx context.Context, req resource.ReadRequest, res
 		return
 	}
 
+	ctx, cancel := operationContext(ctx, data.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
 	// Read API call logic
 	resp.Diagnostics.Append(callHeaderReadAPI(ctx, r, &data)...)
 
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The project was removed outside of Terraform.
+	if data.Id.IsNull() {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -120,9 +155,16 @@ func (r *headerResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
+	ctx, cancel := operationContext(ctx, data.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
 	// Update API call logic
 	resp.Diagnostics.Append(callHeaderCreateUpdateAPI(ctx, r, &data)...)
 
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -137,6 +179,9 @@ func (r *headerResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
+	ctx, cancel := operationContext(ctx, data.Timeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	// Delete API call logic
 	resp.Diagnostics.Append(callHeaderDeleteAPI(ctx, r, &data)...)
 }
@@ -152,6 +197,17 @@ func (r *headerResource) ImportState(ctx context.Context, req resource.ImportSta
 		return
 	}
 
+	if data.Id.IsNull() {
+		resp.Diagnostics.AddError(
+			"Unable to import headers",
+			fmt.Sprintf("No project %s was found.", req.ID),
+		)
+		return
+	}
+
+	// An imported resource has no timeouts block.
+	resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("timeouts"), &data.Timeouts)...)
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -175,6 +231,14 @@ func generateID(headers map[string]string) string {
 	return hex.EncodeToString(hash[:])
 }
 
+// headerOrganization returns the organization of the project the headers
+// belong to and records it in the model.
+func headerOrganization(c *client.Client, resource *headerResourceModel) string {
+	org := organizationValue(c, resource.Organization)
+	resource.Organization = types.StringValue(org)
+	return org
+}
+
 // Create headers with the API.
 func callHeaderCreateUpdateAPI(ctx context.Context, h *headerResource, resource *headerResourceModel) (diags diag.Diagnostics) {
 	req := *openapi.NewHeadersCreateRequestWithDefaults()
@@ -184,13 +248,13 @@ func callHeaderCreateUpdateAPI(ctx context.Context, h *headerResource, resource
 	}
 
 	for k, v := range resource.Headers.Elements() {
-		req.Headers[k] = v.String()
+		req.Headers[k] = v.(types.String).ValueString()
 	}
 
-	_, _, err := h.client.Instance.HeadersAPI.HeadersCreate(h.client.AuthContext, h.client.Organization, resource.Project.ValueString()).HeadersCreateRequest(req).Execute()
+	_, res, err := h.client.Instance.HeadersAPI.HeadersCreate(h.client.RequestContext(ctx), headerOrganization(h.client, resource), resource.Project.ValueString()).HeadersCreateRequest(req).Execute()
 
 	if err != nil {
-		diags.AddError("Failed to add custom headers", err.Error())
+		diags.Append(apiErrorDiagnostics("Failed to add custom headers", res, err, headerAPIFields)...)
 		return
 	}
 
@@ -200,10 +264,17 @@ func callHeaderCreateUpdateAPI(ctx context.Context, h *headerResource, resource
 
 // Load headers from the API.
 func callHeaderReadAPI(ctx context.Context, h *headerResource, resource *headerResourceModel) (diags diag.Diagnostics) {
-	api, _, err := h.client.Instance.HeadersAPI.HeadersList(h.client.AuthContext, h.client.Organization, resource.Project.ValueString()).Execute()
+	api, res, err := h.client.Instance.HeadersAPI.HeadersList(h.client.RequestContext(ctx), headerOrganization(h.client, resource), resource.Project.ValueString()).Execute()
+
+	if utils.IsNotFound(res) {
+		// Signal to the caller that the project no longer exists, a project
+		// without custom headers is an empty map.
+		resource.Id = types.StringNull()
+		return
+	}
 
 	if err != nil {
-		diags.AddError("Error retrieving headers", err.Error())
+		diags.Append(apiErrorDiagnostics("Error retrieving headers", res, err, nil)...)
 		return
 	}
 
@@ -228,9 +299,9 @@ func callHeaderReadAPI(ctx context.Context, h *headerResource, resource *headerR
 func callHeaderDeleteAPI(ctx context.Context, h *headerResource, resource *headerResourceModel) (diags diag.Diagnostics) {
 	req := *openapi.NewHeadersCreateRequestWithDefaults()
 	req.Headers = make(map[string]string, 0)
-	_, _, err := h.client.Instance.HeadersAPI.HeadersCreate(h.client.AuthContext, h.client.Organization, resource.Project.ValueString()).HeadersCreateRequest(req).Execute()
-	if err != nil {
-		diags.AddError("Error removing custom headers", err.Error())
+	_, res, err := h.client.Instance.HeadersAPI.HeadersCreate(h.client.RequestContext(ctx), headerOrganization(h.client, resource), resource.Project.ValueString()).HeadersCreateRequest(req).Execute()
+	if err != nil && !utils.IsNotFound(res) {
+		diags.Append(apiErrorDiagnostics("Error removing custom headers", res, err, nil)...)
 		return
 	}
 	return
@@ -0,0 +1,59 @@
+package provider_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccHeaderResource(t *testing.T) {
+	api := newFakeAPI(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy: func(s *terraform.State) error {
+			api.mu.Lock()
+			defer api.mu.Unlock()
+
+			if n := len(api.headers["api-test"]); n > 0 {
+				return fmt.Errorf("%d custom headers left after destroy", n)
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(api) + `
+resource "quant_header" "test" {
+  project = "api-test"
+  headers = {
+    "X-Frame-Options" = "DENY"
+  }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("quant_header.test", "headers.%", "1"),
+					resource.TestCheckResourceAttr("quant_header.test", "headers.X-Frame-Options", "DENY"),
+				),
+			},
+			{
+				ResourceName:                         "quant_header.test",
+				ImportState:                          true,
+				ImportStateId:                        "api-test",
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "project",
+			},
+			{
+				// An empty map is kept rather than treated as removed.
+				Config: testAccProviderConfig(api) + `
+resource "quant_header" "test" {
+  project = "api-test"
+  headers = {}
+}
+`,
+				Check: resource.TestCheckResourceAttr("quant_header.test", "headers.%", "0"),
+			},
+		},
+	})
+}
@@ -0,0 +1,56 @@
+package provider
+
+import (
+	"regexp"
+	"terraform-provider-quant/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	datasourceschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var organizationValidators = []validator.String{
+	stringvalidator.RegexMatches(regexp.MustCompile(`^[a-z0-9][a-z0-9_-]*$`), "Must be an organization machine name"),
+}
+
+// organizationAttribute is the organization attribute of every resource. It
+// overrides the provider organization so one provider can manage several
+// organizations. The organization in use is kept in state, so changing the
+// provider organization later does not move existing objects, while changing
+// the attribute replaces them.
+func organizationAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		MarkdownDescription: "Organization machine name, defaults to the provider organization",
+		Optional:            true,
+		Computed:            true,
+		Validators:          organizationValidators,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+			stringplanmodifier.RequiresReplace(),
+		},
+	}
+}
+
+// organizationDataSourceAttribute is the organization attribute of every data
+// source.
+func organizationDataSourceAttribute() datasourceschema.StringAttribute {
+	return datasourceschema.StringAttribute{
+		MarkdownDescription: "Organization machine name, defaults to the provider organization",
+		Optional:            true,
+		Computed:            true,
+		Validators:          organizationValidators,
+	}
+}
+
+// organizationValue returns the organization set on a resource or data
+// source, falling back to the provider organization.
+func organizationValue(c *client.Client, org types.String) string {
+	if !org.IsNull() && !org.IsUnknown() && org.ValueString() != "" {
+		return org.ValueString()
+	}
+	return c.Organization
+}
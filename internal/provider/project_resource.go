@@ -5,7 +5,9 @@ import (
 	"fmt"
 	"terraform-provider-quant/internal/client"
 	"terraform-provider-quant/internal/resource_project"
+	"terraform-provider-quant/internal/utils"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -19,6 +21,16 @@ var (
 	_ resource.ResourceWithImportState = (*projectResource)(nil)
 )
 
+// projectAPIFields maps project request fields to their attributes.
+var projectAPIFields = apiFields{
+	"name":                    "name",
+	"region":                  "region",
+	"allow_query_params":      "allow_query_params",
+	"basic_auth_username":     "basic_auth_username",
+	"basic_auth_password":     "basic_auth_password",
+	"basic_auth_preview_only": "basic_auth_preview_only",
+}
+
 func NewProjectResource() resource.Resource {
 	return &projectResource{}
 }
@@ -27,12 +39,20 @@ type projectResource struct {
 	client *client.Client
 }
 
+// projectResourceModel adds the timeouts block to the generated model.
+type projectResourceModel struct {
+	resource_project.ProjectModel
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
 func (r *projectResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_project"
 }
 
 func (r *projectResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = resource_project.ProjectResourceSchema(ctx)
+	resp.Schema.Attributes["organization"] = organizationAttribute()
+	resp.Schema.Blocks = timeoutsBlocks(ctx)
 }
 
 func (r *projectResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -52,7 +72,7 @@ func (r *projectResource) Configure(_ context.Context, req resource.ConfigureReq
 }
 
 func (r *projectResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var data resource_project.ProjectModel
+	var data projectResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -61,21 +81,24 @@ func (r *projectResource) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 
-	resp.Diagnostics.Append(callProjectCreateAPI(ctx, r, &data)...)
+	ctx, cancel := operationContext(ctx, data.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
+	resp.Diagnostics.Append(callProjectCreateAPI(ctx, r, &data.ProjectModel)...)
 
 	if (resp.Diagnostics.HasError()) {
 		return
 	}
 
 	// Read the API results back into the model for Terraform state.
-	resp.Diagnostics.Append(callProjectReadAPI(ctx, r, &data)...)
+	resp.Diagnostics.Append(callProjectReadAPI(ctx, r, &data.ProjectModel)...)
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *projectResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var data resource_project.ProjectModel
+	var data projectResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -84,19 +107,28 @@ func (r *projectResource) Read(ctx context.Context, req resource.ReadRequest, re
 		return
 	}
 
+	ctx, cancel := operationContext(ctx, data.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
 	// Read API call logic
-	resp.Diagnostics.Append(callProjectReadAPI(ctx, r, &data)...)
+	resp.Diagnostics.Append(callProjectReadAPI(ctx, r, &data.ProjectModel)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	// The project was removed outside of Terraform.
+	if data.MachineName.IsNull() {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *projectResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data resource_project.ProjectModel
+	var data projectResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -105,18 +137,21 @@ func (r *projectResource) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 
-	var stateData resource_project.ProjectModel
+	ctx, cancel := operationContext(ctx, data.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
+	var stateData projectResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &stateData)...)
 	data.MachineName = stateData.MachineName
 
 	// Update API call logic
-	resp.Diagnostics.Append(callProjectUpdateAPI(ctx, r, &data)...)
+	resp.Diagnostics.Append(callProjectUpdateAPI(ctx, r, &data.ProjectModel)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	resp.Diagnostics.Append(callProjectReadAPI(ctx, r, &data)...)
+	resp.Diagnostics.Append(callProjectReadAPI(ctx, r, &data.ProjectModel)...)
 
 	if resp.Diagnostics.HasError() {
 		return
@@ -127,7 +162,7 @@ func (r *projectResource) Update(ctx context.Context, req resource.UpdateRequest
 }
 
 func (r *projectResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data resource_project.ProjectModel
+	var data projectResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -136,22 +171,36 @@ func (r *projectResource) Delete(ctx context.Context, req resource.DeleteRequest
 		return
 	}
 
+	ctx, cancel := operationContext(ctx, data.Timeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	// Delete API call logic
-	resp.Diagnostics.Append(callProjectDeleteAPI(ctx, r, &data)...)
+	resp.Diagnostics.Append(callProjectDeleteAPI(ctx, r, &data.ProjectModel)...)
 }
 
 // Import state for a given machine name.
 func (r *projectResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	var data resource_project.ProjectModel
+	var data projectResourceModel
 	data.MachineName = types.StringValue(req.ID)
 
 	// Read API call logic
-	resp.Diagnostics.Append(callProjectReadAPI(ctx, r, &data)...)
+	resp.Diagnostics.Append(callProjectReadAPI(ctx, r, &data.ProjectModel)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	if data.MachineName.IsNull() {
+		resp.Diagnostics.AddError(
+			"Unable to import project",
+			fmt.Sprintf("No project with the machine name %s was found.", req.ID),
+		)
+		return
+	}
+
+	// An imported resource has no timeouts block.
+	resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("timeouts"), &data.Timeouts)...)
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -196,13 +245,13 @@ func callProjectCreateAPI(ctx context.Context, r *projectResource, project *reso
 
 	req.SetRegion(project.Region.ValueString())
 
-	res, _, err := r.client.Instance.ProjectsAPI.ProjectsCreate(r.client.AuthContext, r.client.Organization).ProjectRequest(req).Execute()
+	org := organizationValue(r.client, project.Organization)
+	project.Organization = types.StringValue(org)
+
+	res, httpRes, err := r.client.Instance.ProjectsAPI.ProjectsCreate(r.client.RequestContext(ctx), org).ProjectRequest(req).Execute()
 
 	if err != nil {
-		diags.AddError(
-			"Unable to add the project",
-			fmt.Sprintf("Error: project exists with this name"),
-		)
+		diags.Append(apiErrorDiagnostics("Unable to add the project", httpRes, err, projectAPIFields)...)
 		return
 	}
 
@@ -220,7 +269,7 @@ func callProjectUpdateAPI(ctx context.Context, r *projectResource, project *reso
 		return
 	}
 
-	org := r.client.Organization
+	org := organizationValue(r.client, project.Organization)
 	req := *openapiclient.NewProjectRequestUpdateWithDefaults()
 
 	if project.BasicAuthUsername.IsNull() && !project.BasicAuthPassword.IsNull() {
@@ -245,11 +294,11 @@ func callProjectUpdateAPI(ctx context.Context, r *projectResource, project *reso
 	req.SetBasicAuthUsername(project.BasicAuthUsername.ValueString())
 	req.SetBasicAuthPreviewOnly(project.BasicAuthPreviewOnly.ValueString())
 
-	api := r.client.Instance.ProjectsAPI.ProjectsUpdate(r.client.AuthContext, org, project.MachineName.ValueString())
-	_, _, err := api.ProjectRequestUpdate(req).Execute()
+	api := r.client.Instance.ProjectsAPI.ProjectsUpdate(r.client.RequestContext(ctx), org, project.MachineName.ValueString())
+	_, res, err := api.ProjectRequestUpdate(req).Execute()
 
 	if err != nil {
-		diags.AddError("Unable to update project", fmt.Sprintf("Error: %s", err.Error()))
+		diags.Append(apiErrorDiagnostics("Unable to update project", res, err, projectAPIFields)...)
 	}
 
 	return
@@ -264,14 +313,17 @@ func callProjectReadAPI(ctx context.Context, r *projectResource, project *resour
 		return
 	}
 
-	org := r.client.Organization
-	api, _, err := r.client.Instance.ProjectsAPI.ProjectsRead(r.client.AuthContext, org, project.MachineName.ValueString()).Execute()
+	org := organizationValue(r.client, project.Organization)
+	api, res, err := r.client.Instance.ProjectsAPI.ProjectsRead(r.client.RequestContext(ctx), org, project.MachineName.ValueString()).Execute()
+
+	if utils.IsNotFound(res) {
+		// Signal to the caller that the project no longer exists.
+		project.MachineName = types.StringNull()
+		return
+	}
 
 	if err != nil {
-		diags.Append(diag.NewErrorDiagnostic(
-			"Unable to read project data from API",
-			fmt.Sprintf("There was an issue with the request when reqesting project information form the API, please check the error and update your configuration.\nError: %s", err.Error()),
-		))
+		diags.Append(apiErrorDiagnostics("Unable to read project data from API", res, err, nil)...)
 		return diags
 	}
 
@@ -326,9 +378,7 @@ func callProjectReadAPI(ctx context.Context, r *projectResource, project *resour
 		project.ParentProjectId = types.Int64Null()
 	}
 
-	if project.Organization.IsNull() || project.Organization.IsUnknown() {
-		project.Organization = types.StringNull()
-	}
+	project.Organization = types.StringValue(org)
 
 	if project.DeletedAt.IsNull() || project.DeletedAt.IsUnknown() {
 		project.DeletedAt = types.StringNull()
@@ -347,14 +397,11 @@ func callProjectDeleteAPI(ctx context.Context, r *projectResource, project *reso
 		return
 	}
 
-	org := r.client.Organization
-	_, _, err := r.client.Instance.ProjectsAPI.ProjectsDelete(r.client.AuthContext, org, project.MachineName.ValueString()).Execute()
+	org := organizationValue(r.client, project.Organization)
+	_, res, err := r.client.Instance.ProjectsAPI.ProjectsDelete(r.client.RequestContext(ctx), org, project.MachineName.ValueString()).Execute()
 
-	if err != nil {
-		diags.AddError(
-			"Unable to delete project",
-			fmt.Sprintf("Error: %s", err.Error()),
-		)
+	if err != nil && !utils.IsNotFound(res) {
+		diags.Append(apiErrorDiagnostics("Unable to delete project", res, err, nil)...)
 		return
 	}
 
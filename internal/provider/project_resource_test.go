@@ -5,6 +5,7 @@ import (
 	"os"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	openapi "github.com/quantcdn/quant-admin-go"
 	"github.com/stretchr/testify/assert"
 )
@@ -25,3 +26,44 @@ func TestReadProject(t *testing.T) {
 	assert.Equal(t, "api-test", project.GetName())
 	assert.Equal(t, res.StatusCode, 200)
 }
+
+func TestAccProjectResource(t *testing.T) {
+	api := newFakeAPI(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDestroyed(api, "projects"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(api) + `
+resource "quant_project" "test" {
+  name = "API Test"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("quant_project.test", "machine_name", "api-test"),
+					resource.TestCheckResourceAttr("quant_project.test", "region", "au"),
+					resource.TestCheckResourceAttrSet("quant_project.test", "uuid"),
+				),
+			},
+			{
+				Config: testAccProviderConfig(api) + `
+resource "quant_project" "test" {
+  name               = "API Test"
+  allow_query_params = true
+}
+`,
+				Check: resource.TestCheckResourceAttr("quant_project.test", "allow_query_params", "true"),
+			},
+			{
+				ResourceName:                         "quant_project.test",
+				ImportState:                          true,
+				ImportStateId:                        "api-test",
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "machine_name",
+				// The project read does not copy these from the API.
+				ImportStateVerifyIgnore: []string{"name", "region", "project_type", "allow_query_params", "fastly_migrated"},
+			},
+		},
+	})
+}
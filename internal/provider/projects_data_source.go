@@ -24,7 +24,8 @@ type projectsDataSource struct {
 }
 
 type projectsDataSourceModel struct {
-	Projects []projectModel `tfsdk:"projects"`
+	Organization types.String   `tfsdk:"organization"`
+	Projects     []projectModel `tfsdk:"projects"`
 }
 
 type projectModel struct {
@@ -53,6 +54,7 @@ func (d *projectsDataSource) Configure(_ context.Context, req datasource.Configu
 func (d *projectsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			"organization": organizationDataSourceAttribute(),
 			"projects": schema.ListNestedAttribute{
 				Computed: true,
 				NestedObject: schema.NestedAttributeObject{
@@ -80,14 +82,14 @@ func (d *projectsDataSource) Read(ctx context.Context, req datasource.ReadReques
 		return
 	}
 
+	org := organizationValue(d.client, data.Organization)
+	data.Organization = types.StringValue(org)
+
 	// Read API call logic
-	projects, _, err := d.client.Instance.ProjectsAPI.ProjectsList(d.client.AuthContext, d.client.Organization).Execute()
+	projects, res, err := d.client.Instance.ProjectsAPI.ProjectsList(d.client.RequestContext(ctx), org).Execute()
 
 	if err != nil {
-		resp.Diagnostics.AddError(
-			fmt.Sprintf("Unable to read Quant projects for %s", d.client.Organization),
-			err.Error(),
-		)
+		resp.Diagnostics.Append(apiErrorDiagnostics(fmt.Sprintf("Unable to read Quant projects for %s", org), res, err, nil)...)
 		return
 	}
 
@@ -0,0 +1,133 @@
+package provider_test
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"terraform-provider-quant/internal/provider"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+// testAccProtoV6ProviderFactories starts the provider in process for
+// acceptance tests, pair it with testAccProviderConfig to run them against
+// the fake API instead of QuantCDN.
+var testAccProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
+	"quant": providerserver.NewProtocol6WithError(provider.New("test")()),
+}
+
+func testAccProviderConfig(api *fakeAPI) string {
+	return fmt.Sprintf(`
+provider "quant" {
+  bearer       = "test-token"
+  organization = "quant"
+  endpoint     = %q
+}
+`, api.URL)
+}
+
+// testAccRuleImportId builds the project/uuid import ID of a rule or crawler
+// resource.
+func testAccRuleImportId(name string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return "", fmt.Errorf("resource %s not found in state", name)
+		}
+		return rs.Primary.Attributes["project"] + "/" + rs.Primary.Attributes["uuid"], nil
+	}
+}
+
+// testAccCheckDestroyed verifies the fake API holds nothing of collection
+// after destroy, eg. "projects" or "rules/headers".
+func testAccCheckDestroyed(api *fakeAPI, collection string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		api.mu.Lock()
+		defer api.mu.Unlock()
+
+		for name, objects := range api.objects {
+			if (name == collection || strings.HasSuffix(name, "/"+collection)) && len(objects) > 0 {
+				return fmt.Errorf("%d %s left after destroy", len(objects), collection)
+			}
+		}
+		return nil
+	}
+}
+
+func TestAccProviderCredentials(t *testing.T) {
+	api := newFakeAPI(t)
+	config := func(organization string, skip bool) string {
+		return fmt.Sprintf(`
+provider "quant" {
+  bearer                      = "test-token"
+  organization                = %q
+  endpoint                    = %q
+  skip_credentials_validation = %t
+}
+
+resource "quant_rule_redirect" "test" {
+  project       = "api-test"
+  name          = "credentials"
+  url           = ["/old"]
+  redirect_to   = "https://example.com/new"
+  redirect_code = "301"
+}
+`, organization, api.URL, skip)
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      config("quant-typo", false),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Inaccessible QuantCDN organization`),
+			},
+			{
+				Config:             config("quant-typo", true),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccProviderDefaultProject(t *testing.T) {
+	api := newFakeAPI(t)
+	config := func(defaultProject string) string {
+		return fmt.Sprintf(`
+provider "quant" {
+  bearer          = "test-token"
+  organization    = "quant"
+  endpoint        = %q
+  default_project = %q
+}
+
+resource "quant_rule_redirect" "test" {
+  name          = "default project"
+  url           = ["/old"]
+  redirect_to   = "https://example.com/new"
+  redirect_code = "301"
+}
+`, api.URL, defaultProject)
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      config(""),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Missing project`),
+			},
+			{
+				Config: config("api-test"),
+				Check:  resource.TestCheckResourceAttr("quant_rule_redirect.test", "project", "api-test"),
+			},
+		},
+	})
+}
@@ -0,0 +1,75 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                     = (*ruleAuthResource)(nil)
+	_ resource.ResourceWithConfigure        = (*ruleAuthResource)(nil)
+	_ resource.ResourceWithImportState      = (*ruleAuthResource)(nil)
+	_ resource.ResourceWithConfigValidators = (*ruleAuthResource)(nil)
+	_ resource.ResourceWithModifyPlan       = (*ruleAuthResource)(nil)
+)
+
+func NewRuleAuthResource() resource.Resource {
+	return &ruleAuthResource{
+		ruleType: ruleType[ruleAuthResourceModel, ruleAuthAction]{
+			name:       "auth",
+			attributes: ruleAuthAttributes,
+			toAction:   ruleAuthActionValues,
+			fields:     apiFields{"auth_user": "auth_user", "auth_pass": "auth_pass"},
+			fromAction: setRuleAuthModel,
+		},
+	}
+}
+
+type ruleAuthResource = ruleResource[ruleAuthResourceModel, ruleAuthAction, *ruleAuthResourceModel]
+
+type ruleAuthResourceModel struct {
+	ruleBaseModel
+
+	// Rule specific details.
+	AuthUser types.String `tfsdk:"auth_user"`
+	AuthPass types.String `tfsdk:"auth_pass"`
+}
+
+type ruleAuthAction struct {
+	AuthUser string `json:"auth_user"`
+	AuthPass string `json:"auth_pass"`
+}
+
+func ruleAuthAttributes(ctx context.Context) map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"auth_user": schema.StringAttribute{
+			MarkdownDescription: "Username required to access matched requests",
+			Required:            true,
+		},
+		"auth_pass": schema.StringAttribute{
+			MarkdownDescription: "Password required to access matched requests. The API does not return it, so it is empty after `terraform import` until the next apply sets it",
+			Required:            true,
+			Sensitive:           true,
+		},
+	}
+}
+
+func ruleAuthActionValues(ctx context.Context, rule *ruleAuthResourceModel, diags *diag.Diagnostics) map[string]interface{} {
+	return map[string]interface{}{
+		"auth_user": rule.AuthUser.ValueString(),
+		"auth_pass": rule.AuthPass.ValueString(),
+	}
+}
+
+// setRuleAuthModel reads an auth rule, the password is kept from the plan or
+// state when the API does not return it.
+func setRuleAuthModel(ctx context.Context, rule *ruleAuthResourceModel, action ruleAuthAction, diags *diag.Diagnostics) {
+	rule.AuthUser = types.StringValue(action.AuthUser)
+	if action.AuthPass != "" {
+		rule.AuthPass = types.StringValue(action.AuthPass)
+	}
+}
@@ -0,0 +1,78 @@
+package provider_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"terraform-provider-quant/internal/client"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRuleAuthRequest(t *testing.T) {
+	var body map[string]interface{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/organizations/quant/projects/api-test/rules/auth", r.URL.Path)
+		assert.Nil(t, json.NewDecoder(r.Body).Decode(&body))
+		w.Write([]byte(`{"uuid":"3b6b5c9e-7c3f-4f7e-9a7e-2f8f1c1d2e3f","action":"auth","action_config":{"auth_user":"admin"}}`))
+	}))
+	defer srv.Close()
+
+	c := client.New(context.Background(), "token", "quant", client.Options{Endpoint: srv.URL})
+
+	req := client.RuleRequest{Action: map[string]interface{}{"auth_user": "admin", "auth_pass": "secret"}}
+	req.SetName("staging admin")
+	req.SetUrl([]string{"/admin*"})
+	req.SetMethod("any")
+
+	rule, _, err := c.RulesCreate(context.Background(), "quant", "api-test", "auth", req)
+	assert.Nil(t, err)
+	assert.Equal(t, "staging admin", body["name"])
+	assert.Equal(t, "admin", body["auth_user"])
+	assert.Equal(t, "secret", body["auth_pass"])
+	assert.Equal(t, []interface{}{"/admin*"}, body["url"])
+
+	var action struct {
+		AuthUser string `json:"auth_user"`
+	}
+	assert.Nil(t, rule.DecodeActionConfig(&action))
+	assert.Equal(t, "admin", action.AuthUser)
+}
+
+func TestAccRuleAuthResource(t *testing.T) {
+	api := newFakeAPI(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(api) + `
+resource "quant_rule_auth" "test" {
+  project   = "api-test"
+  name      = "staging admin"
+  url       = ["/admin*"]
+  auth_user = "admin"
+  auth_pass = "secret"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("quant_rule_auth.test", "uuid"),
+					resource.TestCheckResourceAttr("quant_rule_auth.test", "auth_user", "admin"),
+					resource.TestCheckResourceAttr("quant_rule_auth.test", "auth_pass", "secret"),
+				),
+			},
+			{
+				ResourceName:                         "quant_rule_auth.test",
+				ImportState:                          true,
+				ImportStateIdFunc:                    testAccRuleImportId("quant_rule_auth.test"),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "uuid",
+				// The API never returns the password.
+				ImportStateVerifyIgnore: []string{"auth_pass"},
+			},
+		},
+	})
+}
@@ -0,0 +1,95 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                     = (*ruleBotChallengeResource)(nil)
+	_ resource.ResourceWithConfigure        = (*ruleBotChallengeResource)(nil)
+	_ resource.ResourceWithImportState      = (*ruleBotChallengeResource)(nil)
+	_ resource.ResourceWithConfigValidators = (*ruleBotChallengeResource)(nil)
+	_ resource.ResourceWithModifyPlan       = (*ruleBotChallengeResource)(nil)
+)
+
+func NewRuleBotChallengeResource() resource.Resource {
+	return &ruleBotChallengeResource{
+		ruleType: ruleType[ruleBotChallengeResourceModel, ruleBotChallengeAction]{
+			name:       "bot_challenge",
+			attributes: ruleBotChallengeAttributes,
+			toAction:   ruleBotChallengeActionValues,
+			fields: apiFields{
+				"challenge_type":      "challenge_type",
+				"allowed_ips":         "allowed_ips",
+				"allowed_user_agents": "allowed_user_agents",
+			},
+			fromAction: setRuleBotChallengeModel,
+		},
+	}
+}
+
+type ruleBotChallengeResource = ruleResource[ruleBotChallengeResourceModel, ruleBotChallengeAction, *ruleBotChallengeResourceModel]
+
+type ruleBotChallengeResourceModel struct {
+	ruleBaseModel
+
+	// Rule specific details.
+	ChallengeType     types.String `tfsdk:"challenge_type"`
+	AllowedIps        types.List   `tfsdk:"allowed_ips"`
+	AllowedUserAgents types.List   `tfsdk:"allowed_user_agents"`
+}
+
+type ruleBotChallengeAction struct {
+	ChallengeType     string   `json:"challenge_type"`
+	AllowedIps        []string `json:"allowed_ips"`
+	AllowedUserAgents []string `json:"allowed_user_agents"`
+}
+
+func ruleBotChallengeAttributes(ctx context.Context) map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"challenge_type": schema.StringAttribute{
+			MarkdownDescription: "How visitors are challenged, one of invisible or checkbox",
+			Optional:            true,
+			Computed:            true,
+			Default:             stringdefault.StaticString("invisible"),
+			Validators: []validator.String{
+				stringvalidator.OneOf("invisible", "checkbox"),
+			},
+		},
+		"allowed_ips": schema.ListAttribute{
+			MarkdownDescription: "IP addresses or CIDR ranges that are never challenged",
+			ElementType:         types.StringType,
+			Optional:            true,
+		},
+		"allowed_user_agents": schema.ListAttribute{
+			MarkdownDescription: "User agents that are never challenged, eg. known monitoring services",
+			ElementType:         types.StringType,
+			Optional:            true,
+		},
+	}
+}
+
+func ruleBotChallengeActionValues(ctx context.Context, rule *ruleBotChallengeResourceModel, diags *diag.Diagnostics) map[string]interface{} {
+	return map[string]interface{}{
+		"challenge_type":      rule.ChallengeType.ValueString(),
+		"allowed_ips":         ruleListElements(ctx, rule.AllowedIps, diags),
+		"allowed_user_agents": ruleListElements(ctx, rule.AllowedUserAgents, diags),
+	}
+}
+
+// setRuleBotChallengeModel reads a bot challenge rule.
+func setRuleBotChallengeModel(ctx context.Context, rule *ruleBotChallengeResourceModel, action ruleBotChallengeAction, diags *diag.Diagnostics) {
+	if action.ChallengeType != "" {
+		rule.ChallengeType = types.StringValue(action.ChallengeType)
+	}
+	rule.AllowedIps = ruleListValue(ctx, rule.AllowedIps, action.AllowedIps, diags)
+	rule.AllowedUserAgents = ruleListValue(ctx, rule.AllowedUserAgents, action.AllowedUserAgents, diags)
+}
@@ -0,0 +1,41 @@
+package provider_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccRuleBotChallengeResource(t *testing.T) {
+	api := newFakeAPI(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDestroyed(api, "rules/bot_challenge"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(api) + `
+resource "quant_rule_bot_challenge" "test" {
+  project     = "api-test"
+  name        = "protect login"
+  url         = ["/user/login"]
+  allowed_ips = ["10.0.0.0/8"]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("quant_rule_bot_challenge.test", "uuid"),
+					resource.TestCheckResourceAttr("quant_rule_bot_challenge.test", "challenge_type", "invisible"),
+					resource.TestCheckResourceAttr("quant_rule_bot_challenge.test", "allowed_ips.0", "10.0.0.0/8"),
+					resource.TestCheckNoResourceAttr("quant_rule_bot_challenge.test", "allowed_user_agents"),
+				),
+			},
+			{
+				ResourceName:                         "quant_rule_bot_challenge.test",
+				ImportState:                          true,
+				ImportStateIdFunc:                    testAccRuleImportId("quant_rule_bot_challenge.test"),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "uuid",
+			},
+		},
+	})
+}
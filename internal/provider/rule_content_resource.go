@@ -0,0 +1,69 @@
+package provider
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                     = (*ruleContentResource)(nil)
+	_ resource.ResourceWithConfigure        = (*ruleContentResource)(nil)
+	_ resource.ResourceWithImportState      = (*ruleContentResource)(nil)
+	_ resource.ResourceWithConfigValidators = (*ruleContentResource)(nil)
+	_ resource.ResourceWithModifyPlan       = (*ruleContentResource)(nil)
+)
+
+func NewRuleContentResource() resource.Resource {
+	return &ruleContentResource{
+		ruleType: ruleType[ruleContentResourceModel, ruleContentAction]{
+			name:       "content",
+			attributes: ruleContentAttributes,
+			toAction:   ruleContentActionValues,
+			fields:     apiFields{"to": "to"},
+			fromAction: setRuleContentModel,
+		},
+	}
+}
+
+type ruleContentResource = ruleResource[ruleContentResourceModel, ruleContentAction, *ruleContentResourceModel]
+
+type ruleContentResourceModel struct {
+	ruleBaseModel
+
+	// Rule specific details.
+	To types.String `tfsdk:"to"`
+}
+
+type ruleContentAction struct {
+	To string `json:"to"`
+}
+
+func ruleContentAttributes(ctx context.Context) map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"to": schema.StringAttribute{
+			MarkdownDescription: "Internal content path served for matched requests",
+			Required:            true,
+			Validators: []validator.String{
+				stringvalidator.RegexMatches(regexp.MustCompile(`^/\S*$`), "Must be an absolute path"),
+			},
+		},
+	}
+}
+
+func ruleContentActionValues(ctx context.Context, rule *ruleContentResourceModel, diags *diag.Diagnostics) map[string]interface{} {
+	return map[string]interface{}{
+		"to": rule.To.ValueString(),
+	}
+}
+
+// setRuleContentModel reads a content rule.
+func setRuleContentModel(ctx context.Context, rule *ruleContentResourceModel, action ruleContentAction, diags *diag.Diagnostics) {
+	rule.To = types.StringValue(action.To)
+}
@@ -0,0 +1,39 @@
+package provider_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccRuleContentResource(t *testing.T) {
+	api := newFakeAPI(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDestroyed(api, "rules/content"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(api) + `
+resource "quant_rule_content" "test" {
+  project = "api-test"
+  name    = "landing page"
+  url     = ["/"]
+  to      = "/campaigns/spring.html"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("quant_rule_content.test", "uuid"),
+					resource.TestCheckResourceAttr("quant_rule_content.test", "to", "/campaigns/spring.html"),
+				),
+			},
+			{
+				ResourceName:                         "quant_rule_content.test",
+				ImportState:                          true,
+				ImportStateIdFunc:                    testAccRuleImportId("quant_rule_content.test"),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "uuid",
+			},
+		},
+	})
+}
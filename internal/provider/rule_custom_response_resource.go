@@ -0,0 +1,108 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                     = (*ruleCustomResponseResource)(nil)
+	_ resource.ResourceWithConfigure        = (*ruleCustomResponseResource)(nil)
+	_ resource.ResourceWithImportState      = (*ruleCustomResponseResource)(nil)
+	_ resource.ResourceWithConfigValidators = (*ruleCustomResponseResource)(nil)
+	_ resource.ResourceWithModifyPlan       = (*ruleCustomResponseResource)(nil)
+)
+
+func NewRuleCustomResponseResource() resource.Resource {
+	return &ruleCustomResponseResource{
+		ruleType: ruleType[ruleCustomResponseResourceModel, ruleCustomResponseAction]{
+			name:       "custom_response",
+			attributes: ruleCustomResponseAttributes,
+			toAction:   ruleCustomResponseActionValues,
+			fields: apiFields{
+				"status_code":  "status_code",
+				"body":         "body",
+				"content_type": "content_type",
+			},
+			fromAction: setRuleCustomResponseModel,
+		},
+	}
+}
+
+type ruleCustomResponseResource = ruleResource[ruleCustomResponseResourceModel, ruleCustomResponseAction, *ruleCustomResponseResourceModel]
+
+type ruleCustomResponseResourceModel struct {
+	ruleBaseModel
+
+	// Rule specific details.
+	StatusCode  types.Int64  `tfsdk:"status_code"`
+	Body        types.String `tfsdk:"body"`
+	ContentType types.String `tfsdk:"content_type"`
+}
+
+type ruleCustomResponseAction struct {
+	// The API returns the status code as a string, eg. "451".
+	StatusCode  json.Number `json:"status_code"`
+	Body        string      `json:"body"`
+	ContentType string      `json:"content_type"`
+}
+
+func ruleCustomResponseAttributes(ctx context.Context) map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"status_code": schema.Int64Attribute{
+			MarkdownDescription: "HTTP status code returned for matched requests",
+			Required:            true,
+			Validators: []validator.Int64{
+				int64validator.Between(100, 599),
+			},
+		},
+		"body": schema.StringAttribute{
+			MarkdownDescription: "Response body returned for matched requests",
+			Optional:            true,
+		},
+		"content_type": schema.StringAttribute{
+			MarkdownDescription: "Content-Type of the response, defaults to text/html",
+			Optional:            true,
+			Computed:            true,
+			Default:             stringdefault.StaticString("text/html"),
+		},
+	}
+}
+
+func ruleCustomResponseActionValues(ctx context.Context, rule *ruleCustomResponseResourceModel, diags *diag.Diagnostics) map[string]interface{} {
+	return map[string]interface{}{
+		"status_code":  rule.StatusCode.ValueInt64(),
+		"body":         rule.Body.ValueString(),
+		"content_type": rule.ContentType.ValueString(),
+	}
+}
+
+// setRuleCustomResponseModel reads a custom response rule.
+func setRuleCustomResponseModel(ctx context.Context, rule *ruleCustomResponseResourceModel, action ruleCustomResponseAction, diags *diag.Diagnostics) {
+	if action.StatusCode != "" {
+		statusCode, err := action.StatusCode.Int64()
+		if err != nil {
+			diags.AddError(
+				"Failed to read rule action",
+				fmt.Sprintf("Invalid status_code %q: %s", action.StatusCode, err),
+			)
+			return
+		}
+		rule.StatusCode = types.Int64Value(statusCode)
+	}
+	if action.Body != "" || !rule.Body.IsNull() {
+		rule.Body = types.StringValue(action.Body)
+	}
+	if action.ContentType != "" {
+		rule.ContentType = types.StringValue(action.ContentType)
+	}
+}
@@ -0,0 +1,101 @@
+package provider_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"terraform-provider-quant/internal/client"
+	"terraform-provider-quant/internal/provider"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	resourcetest "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRuleCustomResponseValidation(t *testing.T) {
+	ctx := context.Background()
+	resp := &resource.SchemaResponse{}
+	provider.NewRuleCustomResponseResource().Schema(ctx, resource.SchemaRequest{}, resp)
+	assert.False(t, resp.Diagnostics.HasError())
+
+	statusCode := resp.Schema.Attributes["status_code"].(schema.Int64Attribute)
+	for code, valid := range map[int64]bool{99: false, 200: true, 451: true, 503: true, 600: false} {
+		res := &validator.Int64Response{}
+		for _, v := range statusCode.Validators {
+			v.ValidateInt64(ctx, validator.Int64Request{Path: path.Root("status_code"), ConfigValue: types.Int64Value(code)}, res)
+		}
+		assert.Equal(t, valid, !res.Diagnostics.HasError(), code)
+	}
+}
+
+func TestRuleCustomResponseStatusCode(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/organizations/quant/projects/api-test/rules/custom_response/3b6b5c9e-7c3f-4f7e-9a7e-2f8f1c1d2e3f", r.URL.Path)
+		w.Write([]byte(`{"uuid":"3b6b5c9e-7c3f-4f7e-9a7e-2f8f1c1d2e3f","name":"blocked","url":["/*"],"domain":["any"],"action":"custom_response","action_config":{"status_code":"451","body":"Unavailable","content_type":"text/plain"}}`))
+	}))
+	defer srv.Close()
+
+	ctx := context.Background()
+	r := provider.NewRuleCustomResponseResource()
+	r.(resource.ResourceWithConfigure).Configure(ctx, resource.ConfigureRequest{
+		ProviderData: client.New(ctx, "token", "quant", client.Options{Endpoint: srv.URL}),
+	}, &resource.ConfigureResponse{})
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	resp := &resource.ImportStateResponse{
+		State: tfsdk.State{
+			Schema: schemaResp.Schema,
+			Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+		},
+	}
+	r.(resource.ResourceWithImportState).ImportState(ctx, resource.ImportStateRequest{ID: "api-test/3b6b5c9e-7c3f-4f7e-9a7e-2f8f1c1d2e3f"}, resp)
+	assert.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+	var statusCode types.Int64
+	resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("status_code"), &statusCode)...)
+	assert.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+	assert.Equal(t, int64(451), statusCode.ValueInt64())
+}
+
+func TestAccRuleCustomResponseResource(t *testing.T) {
+	api := newFakeAPI(t)
+
+	resourcetest.Test(t, resourcetest.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDestroyed(api, "rules/custom_response"),
+		Steps: []resourcetest.TestStep{
+			{
+				Config: testAccProviderConfig(api) + `
+resource "quant_rule_custom_response" "test" {
+  project     = "api-test"
+  name        = "blocked"
+  url         = ["/*"]
+  status_code = 451
+  body        = "Unavailable"
+}
+`,
+				Check: resourcetest.ComposeAggregateTestCheckFunc(
+					resourcetest.TestCheckResourceAttrSet("quant_rule_custom_response.test", "uuid"),
+					resourcetest.TestCheckResourceAttr("quant_rule_custom_response.test", "status_code", "451"),
+					resourcetest.TestCheckResourceAttr("quant_rule_custom_response.test", "content_type", "text/html"),
+				),
+			},
+			{
+				ResourceName:                         "quant_rule_custom_response.test",
+				ImportState:                          true,
+				ImportStateIdFunc:                    testAccRuleImportId("quant_rule_custom_response.test"),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "uuid",
+			},
+		},
+	})
+}
@@ -0,0 +1,69 @@
+package provider
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                     = (*ruleFunctionResource)(nil)
+	_ resource.ResourceWithConfigure        = (*ruleFunctionResource)(nil)
+	_ resource.ResourceWithImportState      = (*ruleFunctionResource)(nil)
+	_ resource.ResourceWithConfigValidators = (*ruleFunctionResource)(nil)
+	_ resource.ResourceWithModifyPlan       = (*ruleFunctionResource)(nil)
+)
+
+func NewRuleFunctionResource() resource.Resource {
+	return &ruleFunctionResource{
+		ruleType: ruleType[ruleFunctionResourceModel, ruleFunctionAction]{
+			name:       "function",
+			attributes: ruleFunctionAttributes,
+			toAction:   ruleFunctionActionValues,
+			fields:     apiFields{"fn_uuid": "function_uuid"},
+			fromAction: setRuleFunctionModel,
+		},
+	}
+}
+
+type ruleFunctionResource = ruleResource[ruleFunctionResourceModel, ruleFunctionAction, *ruleFunctionResourceModel]
+
+type ruleFunctionResourceModel struct {
+	ruleBaseModel
+
+	// Rule specific details.
+	FunctionUuid types.String `tfsdk:"function_uuid"`
+}
+
+type ruleFunctionAction struct {
+	FunctionUuid string `json:"fn_uuid"`
+}
+
+func ruleFunctionAttributes(ctx context.Context) map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"function_uuid": schema.StringAttribute{
+			MarkdownDescription: "The uuid of the edge function that handles matched requests",
+			Required:            true,
+			Validators: []validator.String{
+				stringvalidator.RegexMatches(regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`), "Must be a valid UUID"),
+			},
+		},
+	}
+}
+
+func ruleFunctionActionValues(ctx context.Context, rule *ruleFunctionResourceModel, diags *diag.Diagnostics) map[string]interface{} {
+	return map[string]interface{}{
+		"fn_uuid": rule.FunctionUuid.ValueString(),
+	}
+}
+
+// setRuleFunctionModel reads a function rule.
+func setRuleFunctionModel(ctx context.Context, rule *ruleFunctionResourceModel, action ruleFunctionAction, diags *diag.Diagnostics) {
+	rule.FunctionUuid = types.StringValue(action.FunctionUuid)
+}
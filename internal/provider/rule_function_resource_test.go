@@ -0,0 +1,39 @@
+package provider_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccRuleFunctionResource(t *testing.T) {
+	api := newFakeAPI(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDestroyed(api, "rules/function"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(api) + `
+resource "quant_rule_function" "test" {
+  project       = "api-test"
+  name          = "geo redirect"
+  url           = ["/shop/*"]
+  function_uuid = "3b6b5c9e-7c3f-4f7e-9a7e-2f8f1c1d2e3f"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("quant_rule_function.test", "uuid"),
+					resource.TestCheckResourceAttr("quant_rule_function.test", "function_uuid", "3b6b5c9e-7c3f-4f7e-9a7e-2f8f1c1d2e3f"),
+				),
+			},
+			{
+				ResourceName:                         "quant_rule_function.test",
+				ImportState:                          true,
+				ImportStateIdFunc:                    testAccRuleImportId("quant_rule_function.test"),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "uuid",
+			},
+		},
+	})
+}
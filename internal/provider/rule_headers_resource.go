@@ -0,0 +1,72 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                     = (*ruleHeadersResource)(nil)
+	_ resource.ResourceWithConfigure        = (*ruleHeadersResource)(nil)
+	_ resource.ResourceWithImportState      = (*ruleHeadersResource)(nil)
+	_ resource.ResourceWithConfigValidators = (*ruleHeadersResource)(nil)
+	_ resource.ResourceWithModifyPlan       = (*ruleHeadersResource)(nil)
+)
+
+func NewRuleHeadersResource() resource.Resource {
+	return &ruleHeadersResource{
+		ruleType: ruleType[ruleHeadersResourceModel, ruleHeadersAction]{
+			name:       "headers",
+			attributes: ruleHeadersAttributes,
+			toAction:   ruleHeadersActionValues,
+			fields:     apiFields{"headers": "headers"},
+			fromAction: setRuleHeadersModel,
+		},
+	}
+}
+
+type ruleHeadersResource = ruleResource[ruleHeadersResourceModel, ruleHeadersAction, *ruleHeadersResourceModel]
+
+type ruleHeadersResourceModel struct {
+	ruleBaseModel
+
+	// Rule specific details.
+	Headers types.Map `tfsdk:"headers"`
+}
+
+type ruleHeadersAction struct {
+	Headers map[string]string `json:"headers"`
+}
+
+func ruleHeadersAttributes(ctx context.Context) map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"headers": schema.MapAttribute{
+			MarkdownDescription: "Response headers to set on matched requests",
+			ElementType:         types.StringType,
+			Required:            true,
+		},
+	}
+}
+
+func ruleHeadersActionValues(ctx context.Context, rule *ruleHeadersResourceModel, diags *diag.Diagnostics) map[string]interface{} {
+	headers := map[string]string{}
+	diags.Append(rule.Headers.ElementsAs(ctx, &headers, false)...)
+	return map[string]interface{}{
+		"headers": headers,
+	}
+}
+
+// setRuleHeadersModel reads a headers rule.
+func setRuleHeadersModel(ctx context.Context, rule *ruleHeadersResourceModel, action ruleHeadersAction, diags *diag.Diagnostics) {
+	if action.Headers == nil {
+		action.Headers = map[string]string{}
+	}
+
+	headers, d := types.MapValueFrom(ctx, types.StringType, action.Headers)
+	diags.Append(d...)
+	rule.Headers = headers
+}
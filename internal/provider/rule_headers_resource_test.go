@@ -0,0 +1,42 @@
+package provider_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccRuleHeadersResource(t *testing.T) {
+	api := newFakeAPI(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDestroyed(api, "rules/headers"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(api) + `
+resource "quant_rule_headers" "test" {
+  project = "api-test"
+  name    = "cache assets"
+  url     = ["/assets/*"]
+  headers = {
+    "Cache-Control" = "public, max-age=31536000"
+  }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("quant_rule_headers.test", "uuid"),
+					resource.TestCheckResourceAttr("quant_rule_headers.test", "headers.%", "1"),
+					resource.TestCheckResourceAttr("quant_rule_headers.test", "headers.Cache-Control", "public, max-age=31536000"),
+				),
+			},
+			{
+				ResourceName:                         "quant_rule_headers.test",
+				ImportState:                          true,
+				ImportStateIdFunc:                    testAccRuleImportId("quant_rule_headers.test"),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "uuid",
+			},
+		},
+	})
+}
@@ -15,11 +15,36 @@ import (
 )
 
 var (
-	_ resource.Resource                = (*ruleProxyResource)(nil)
-	_ resource.ResourceWithConfigure   = (*ruleProxyResource)(nil)
-	_ resource.ResourceWithImportState = (*ruleProxyResource)(nil)
+	_ resource.Resource                     = (*ruleProxyResource)(nil)
+	_ resource.ResourceWithConfigure        = (*ruleProxyResource)(nil)
+	_ resource.ResourceWithImportState      = (*ruleProxyResource)(nil)
+	_ resource.ResourceWithConfigValidators = (*ruleProxyResource)(nil)
+	_ resource.ResourceWithModifyPlan       = (*ruleProxyResource)(nil)
 )
 
+// ruleProxyAPIFields maps proxy rule request fields to their attributes.
+var ruleProxyAPIFields = ruleAPIFields(apiFields{
+	"cookie_name":                  "cookie_name",
+	"to":                           "to",
+	"host":                         "host",
+	"cache_lifetime":               "cache_lifetime",
+	"auth_user":                    "auth_user",
+	"auth_pass":                    "auth_pass",
+	"disable_ssl_verify":           "disable_ssl_verify",
+	"only_proxy_404":               "only_proxy_404",
+	"inject_headers":               "inject_headers",
+	"proxy_strip_headers":          "proxy_strip_headers",
+	"proxy_strip_request_headers":  "proxy_strip_request_headers",
+	"failover_mode":                "failover_mode",
+	"failover_lifetime":            "failover_lifetime",
+	"failover_origin_status_codes": "failover_origin_status_codes",
+	"failover_origin_ttfb":         "failover_origin_ttfb",
+	"notify":                       "notify",
+	"notify_config":                "notify_config",
+	"waf_enabled":                  "waf_enabled",
+	"waf_config":                   "waf_config",
+})
+
 func NewRuleProxyResource() resource.Resource {
 	return &ruleProxyResource{}
 }
@@ -29,42 +54,27 @@ type ruleProxyResource struct {
 }
 
 type ruleProxyResourceModel struct {
+	ruleBaseModel
+
+	// Rule specific details.
 	AuthPass                  types.String       `tfsdk:"auth_pass"`
 	AuthUser                  types.String       `tfsdk:"auth_user"`
 	CacheLifetime             types.Int64        `tfsdk:"cache_lifetime"`
 	CookieName                types.String       `tfsdk:"cookie_name"`
-	Country                   types.String       `tfsdk:"country"`
-	CountryIs                 types.List         `tfsdk:"country_is"`
-	CountryIsNot              types.List         `tfsdk:"country_is_not"`
 	DisableSslVerify          types.Bool         `tfsdk:"disable_ssl_verify"`
-	Disabled                  types.Bool         `tfsdk:"disabled"`
-	Domain                    types.List         `tfsdk:"domain"`
 	FailoverLifetime          types.String       `tfsdk:"failover_lifetime"`
 	FailoverMode              types.Bool         `tfsdk:"failover_mode"`
 	FailoverOriginStatusCodes types.List         `tfsdk:"failover_origin_status_codes"`
 	FailoverOriginTtfb        types.String       `tfsdk:"failover_origin_ttfb"`
 	Host                      types.String       `tfsdk:"host"`
 	InjectHeaders             types.Map          `tfsdk:"inject_headers"`
-	Ip                        types.String       `tfsdk:"ip"`
-	IpIs                      types.List         `tfsdk:"ip_is"`
-	IpIsNot                   types.List         `tfsdk:"ip_is_not"`
-	Method                    types.String       `tfsdk:"method"`
-	MethodIs                  types.List         `tfsdk:"method_is"`
-	MethodIsNot               types.List         `tfsdk:"method_is_not"`
-	Name                      types.String       `tfsdk:"name"`
 	Notify                    types.String       `tfsdk:"notify"`
 	NotifyConfig              *NotifyConfigValue `tfsdk:"notify_config"`
 	OnlyProxy404              types.Bool         `tfsdk:"only_proxy_404"`
-	OnlyWithCookie            types.Bool         `tfsdk:"only_with_cookie"`
-	Organization              types.String       `tfsdk:"organization"`
-	Project                   types.String       `tfsdk:"project"`
 	ProxyStripHeaders         types.List         `tfsdk:"proxy_strip_headers"`
 	ProxyStripRequestHeaders  types.List         `tfsdk:"proxy_strip_request_headers"`
 	Rule                      types.String       `tfsdk:"rule"`
-	RuleId                    types.String       `tfsdk:"rule_id"`
 	To                        types.String       `tfsdk:"to"`
-	Url                       types.List         `tfsdk:"url"`
-	Uuid                      types.String       `tfsdk:"uuid"`
 	WafConfig                 *WafConfigValue    `tfsdk:"waf_config"`
 	WafEnabled                types.Bool         `tfsdk:"waf_enabled"`
 }
@@ -122,262 +132,229 @@ func (r *ruleProxyResource) Metadata(ctx context.Context, req resource.MetadataR
 }
 
 func (r *ruleProxyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
-	resp.Schema = schema.Schema{
-		Attributes: map[string]schema.Attribute{
-			"uuid": schema.StringAttribute{
-				Computed: true,
-			},
-			"rule_id": schema.StringAttribute{
-				Computed: true,
-			},
-			"auth_pass": schema.StringAttribute{
-				Optional: true,
-			},
-			"auth_user": schema.StringAttribute{
-				Optional: true,
-			},
-			"cache_lifetime": schema.Int64Attribute{
-				Optional: true,
-			},
-			"cookie_name": schema.StringAttribute{
-				Optional: true,
-			},
-			"country": schema.StringAttribute{
-				Optional: true,
-			},
-			"country_is": schema.ListAttribute{
-				ElementType: types.StringType,
-				Optional:    true,
-			},
-			"country_is_not": schema.ListAttribute{
-				ElementType: types.StringType,
-				Optional:    true,
-			},
-			"disable_ssl_verify": schema.BoolAttribute{
-				Optional: true,
-			},
-			"disabled": schema.BoolAttribute{
-				Optional: true,
-			},
-			"domain": schema.ListAttribute{
-				ElementType: types.StringType,
-				Optional:    true,
-			},
-			"failover_lifetime": schema.StringAttribute{
-				Optional: true,
-			},
-			"failover_mode": schema.BoolAttribute{
-				Optional: true,
-			},
-			"failover_origin_status_codes": schema.ListAttribute{
-				ElementType: types.StringType,
-				Optional:    true,
-			},
-			"failover_origin_ttfb": schema.StringAttribute{
-				Optional: true,
-			},
-			"host": schema.StringAttribute{
-				Optional: true,
-			},
-			"inject_headers": schema.MapAttribute{
-				ElementType: types.StringType,
-				Optional:    true,
-			},
-			"ip": schema.StringAttribute{
-				Optional: true,
-			},
-			"ip_is": schema.ListAttribute{
-				ElementType: types.StringType,
-				Optional:    true,
-			},
-			"ip_is_not": schema.ListAttribute{
-				ElementType: types.StringType,
-				Optional:    true,
-			},
-			"method": schema.StringAttribute{
-				Optional: true,
-			},
-			"method_is": schema.ListAttribute{
-				ElementType: types.StringType,
-				Optional:    true,
-			},
-			"method_is_not": schema.ListAttribute{
-				ElementType: types.StringType,
-				Optional:    true,
-			},
-			"name": schema.StringAttribute{
-				Required: true,
-			},
-			"notify": schema.StringAttribute{
-				Optional: true,
-			},
-			"notify_config": schema.SingleNestedAttribute{
-				Optional: true,
-				Attributes: map[string]schema.Attribute{
-					"origin_status_codes": schema.ListAttribute{
-						ElementType: types.Int64Type,
-						Optional:    true,
-					},
-					"period": schema.StringAttribute{
-						Optional: true,
-					},
-					"slack_webhook": schema.StringAttribute{
-						Optional: true,
-					},
+	attributes := RuleBaseAttributes(ctx)
+	proxyAttributes := map[string]schema.Attribute{
+		"auth_pass": schema.StringAttribute{
+			Optional: true,
+		},
+		"auth_user": schema.StringAttribute{
+			Optional: true,
+		},
+		"cache_lifetime": schema.Int64Attribute{
+			Optional: true,
+		},
+		"cookie_name": schema.StringAttribute{
+			Optional: true,
+		},
+		"disable_ssl_verify": schema.BoolAttribute{
+			Optional: true,
+		},
+		"failover_lifetime": schema.StringAttribute{
+			Optional: true,
+		},
+		"failover_mode": schema.BoolAttribute{
+			Optional: true,
+		},
+		"failover_origin_status_codes": schema.ListAttribute{
+			ElementType: types.StringType,
+			Optional:    true,
+		},
+		"failover_origin_ttfb": schema.StringAttribute{
+			Optional: true,
+		},
+		"host": schema.StringAttribute{
+			Optional: true,
+		},
+		"inject_headers": schema.MapAttribute{
+			ElementType: types.StringType,
+			Optional:    true,
+		},
+		"notify": schema.StringAttribute{
+			Optional: true,
+		},
+		"notify_config": schema.SingleNestedAttribute{
+			Optional: true,
+			Attributes: map[string]schema.Attribute{
+				"origin_status_codes": schema.ListAttribute{
+					ElementType: types.Int64Type,
+					Optional:    true,
+				},
+				"period": schema.StringAttribute{
+					Optional: true,
+				},
+				"slack_webhook": schema.StringAttribute{
+					Optional: true,
 				},
 			},
-			"only_proxy_404": schema.BoolAttribute{
-				Optional: true,
-			},
-			"only_with_cookie": schema.BoolAttribute{
-				Optional: true,
-			},
-			"organization": schema.StringAttribute{
-				Optional: true,
-			},
-			"project": schema.StringAttribute{
-				Optional: true,
-			},
-			"proxy_strip_headers": schema.ListAttribute{
-				ElementType: types.StringType,
-				Optional:    true,
-			},
-			"proxy_strip_request_headers": schema.ListAttribute{
-				ElementType: types.StringType,
-				Optional:    true,
-			},
-			"rule": schema.StringAttribute{
-				Optional: true,
-			},
-			"to": schema.StringAttribute{
-				Optional: true,
-			},
-			"url": schema.ListAttribute{
-				ElementType: types.StringType,
-				Optional:    true,
-			},
-			"waf_config": schema.SingleNestedAttribute{
-				Optional: true,
-				Attributes: map[string]schema.Attribute{
-					"allow_ip": schema.ListAttribute{
-						ElementType: types.StringType,
-						Optional:    true,
-					},
-					"allow_rules": schema.ListAttribute{
-						ElementType: types.StringType,
-						Optional:    true,
-					},
-					"block_ip": schema.ListAttribute{
-						ElementType: types.StringType,
-						Optional:    true,
-					},
-					"block_lists": schema.SingleNestedAttribute{
-						Optional: true,
-						Attributes: map[string]schema.Attribute{
-							"referer": schema.BoolAttribute{
-								Optional: true,
-							},
-							"ip": schema.BoolAttribute{
-								Optional: true,
-							},
-							"user_agent": schema.BoolAttribute{
-								Optional: true,
-							},
-							"ai": schema.BoolAttribute{
-								Optional: true,
-							},
+		},
+		"only_proxy_404": schema.BoolAttribute{
+			Optional: true,
+		},
+		"proxy_strip_headers": schema.ListAttribute{
+			ElementType: types.StringType,
+			Optional:    true,
+		},
+		"proxy_strip_request_headers": schema.ListAttribute{
+			ElementType: types.StringType,
+			Optional:    true,
+		},
+		"rule": schema.StringAttribute{
+			Optional: true,
+		},
+		"to": schema.StringAttribute{
+			Optional: true,
+		},
+		"waf_config": schema.SingleNestedAttribute{
+			Optional: true,
+			Attributes: map[string]schema.Attribute{
+				"allow_ip": schema.ListAttribute{
+					ElementType: types.StringType,
+					Optional:    true,
+				},
+				"allow_rules": schema.ListAttribute{
+					ElementType: types.StringType,
+					Optional:    true,
+				},
+				"block_ip": schema.ListAttribute{
+					ElementType: types.StringType,
+					Optional:    true,
+				},
+				"block_lists": schema.SingleNestedAttribute{
+					Optional: true,
+					Attributes: map[string]schema.Attribute{
+						"referer": schema.BoolAttribute{
+							Optional: true,
 						},
-					},
-					"block_referer": schema.ListAttribute{
-						ElementType: types.StringType,
-						Optional:    true,
-					},
-					"block_ua": schema.ListAttribute{
-						ElementType: types.StringType,
-						Optional:    true,
-					},
-					"httpbl": schema.SingleNestedAttribute{
-						Optional: true,
-						Attributes: map[string]schema.Attribute{
-							"enabled": schema.BoolAttribute{
-								Optional: true,
-							},
-							"api_key": schema.StringAttribute{
-								Optional: true,
-							},
-							"block_suspicious": schema.BoolAttribute{
-								Optional: true,
-							},
-							"block_harvester": schema.BoolAttribute{
-								Optional: true,
-							},
-							"block_spam": schema.BoolAttribute{
-								Optional: true,
-							},
-							"block_search_engine": schema.BoolAttribute{
-								Optional: true,
-							},
+						"ip": schema.BoolAttribute{
+							Optional: true,
+						},
+						"user_agent": schema.BoolAttribute{
+							Optional: true,
+						},
+						"ai": schema.BoolAttribute{
+							Optional: true,
 						},
 					},
-					"ip_ratelimit_cooldown": schema.Int64Attribute{
-						Optional: true,
-					},
-					"ip_ratelimit_mode": schema.StringAttribute{
-						Optional: true,
-					},
-					"ip_ratelimit_rps": schema.Int64Attribute{
-						Optional: true,
-					},
-					"mode": schema.StringAttribute{
-						Optional: true,
-					},
-					"notify_email": schema.ListAttribute{
-						ElementType: types.StringType,
-						Optional:    true,
-					},
-					"notify_slack": schema.StringAttribute{
-						Optional: true,
-					},
-					"notify_slack_hits_rpm": schema.Int64Attribute{
-						Optional: true,
-					},
-					"notify_slack_rpm": schema.Int64Attribute{
-						Optional: true,
-					},
-					"paranoia_level": schema.Int64Attribute{
-						Optional: true,
-					},
-					"request_header_name": schema.StringAttribute{
-						Optional: true,
-					},
-					"request_header_ratelimit_cooldown": schema.Int64Attribute{
-						Optional: true,
-					},
-					"request_header_ratelimit_mode": schema.StringAttribute{
-						Optional: true,
-					},
-					"request_header_ratelimit_rps": schema.Int64Attribute{
-						Optional: true,
-					},
-					"waf_ratelimit_cooldown": schema.Int64Attribute{
-						Optional: true,
-					},
-					"waf_ratelimit_hits": schema.Int64Attribute{
-						Optional: true,
-					},
-					"waf_ratelimit_mode": schema.StringAttribute{
-						Optional: true,
-					},
-					"waf_ratelimit_rps": schema.Int64Attribute{
-						Optional: true,
+				},
+				"block_referer": schema.ListAttribute{
+					ElementType: types.StringType,
+					Optional:    true,
+				},
+				"block_ua": schema.ListAttribute{
+					ElementType: types.StringType,
+					Optional:    true,
+				},
+				"httpbl": schema.SingleNestedAttribute{
+					Optional: true,
+					Attributes: map[string]schema.Attribute{
+						"enabled": schema.BoolAttribute{
+							Optional: true,
+						},
+						"api_key": schema.StringAttribute{
+							Optional: true,
+						},
+						"block_suspicious": schema.BoolAttribute{
+							Optional: true,
+						},
+						"block_harvester": schema.BoolAttribute{
+							Optional: true,
+						},
+						"block_spam": schema.BoolAttribute{
+							Optional: true,
+						},
+						"block_search_engine": schema.BoolAttribute{
+							Optional: true,
+						},
 					},
 				},
+				"ip_ratelimit_cooldown": schema.Int64Attribute{
+					Optional: true,
+				},
+				"ip_ratelimit_mode": schema.StringAttribute{
+					Optional: true,
+				},
+				"ip_ratelimit_rps": schema.Int64Attribute{
+					Optional: true,
+				},
+				"mode": schema.StringAttribute{
+					Optional: true,
+				},
+				"notify_email": schema.ListAttribute{
+					ElementType: types.StringType,
+					Optional:    true,
+				},
+				"notify_slack": schema.StringAttribute{
+					Optional: true,
+				},
+				"notify_slack_hits_rpm": schema.Int64Attribute{
+					Optional: true,
+				},
+				"notify_slack_rpm": schema.Int64Attribute{
+					Optional: true,
+				},
+				"paranoia_level": schema.Int64Attribute{
+					Optional: true,
+				},
+				"request_header_name": schema.StringAttribute{
+					Optional: true,
+				},
+				"request_header_ratelimit_cooldown": schema.Int64Attribute{
+					Optional: true,
+				},
+				"request_header_ratelimit_mode": schema.StringAttribute{
+					Optional: true,
+				},
+				"request_header_ratelimit_rps": schema.Int64Attribute{
+					Optional: true,
+				},
+				"waf_ratelimit_cooldown": schema.Int64Attribute{
+					Optional: true,
+				},
+				"waf_ratelimit_hits": schema.Int64Attribute{
+					Optional: true,
+				},
+				"waf_ratelimit_mode": schema.StringAttribute{
+					Optional: true,
+				},
+				"waf_ratelimit_rps": schema.Int64Attribute{
+					Optional: true,
+				},
 			},
-			"waf_enabled": schema.BoolAttribute{
-				Optional: true,
-			},
 		},
+		"waf_enabled": schema.BoolAttribute{
+			Optional: true,
+		},
+	}
+	for name, attribute := range proxyAttributes {
+		attributes[name] = attribute
+	}
+
+	// Proxy rules predate the shared criteria attributes, keep their plain
+	// optional domain, disabled and selectors so existing state does not
+	// show a diff.
+	attributes["domain"] = schema.ListAttribute{
+		ElementType: types.StringType,
+		Optional:    true,
+	}
+	attributes["disabled"] = schema.BoolAttribute{
+		Optional: true,
 	}
+	for _, filter := range []string{"method", "ip", "country"} {
+		attributes[filter] = schema.StringAttribute{
+			Optional: true,
+		}
+	}
+
+	// Set the attributes for this rule.
+	resp.Schema = schema.Schema{Attributes: attributes, Blocks: timeoutsBlocks(ctx)}
+}
+
+func (r *ruleProxyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyPlanProject(ctx, r.client, req, resp)
+}
+
+func (r *ruleProxyResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return RuleBaseConfigValidator()
 }
 
 func (r *ruleProxyResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -404,6 +381,9 @@ func (r *ruleProxyResource) Create(ctx context.Context, req resource.CreateReque
 		return
 	}
 
+	ctx, cancel := operationContext(ctx, data.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
 	// Create API call logic
 	resp.Diagnostics.Append(callRuleProxyCreateAPI(ctx, r, &data)...)
 
@@ -425,6 +405,9 @@ func (r *ruleProxyResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
+	ctx, cancel := operationContext(ctx, data.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
 	// Read API call logic
 	resp.Diagnostics.Append(callRuleProxyReadAPI(ctx, r, &data)...)
 
@@ -432,6 +415,12 @@ func (r *ruleProxyResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
+	// The rule was removed outside of Terraform.
+	if data.RuleId.IsNull() {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -446,6 +435,9 @@ func (r *ruleProxyResource) Update(ctx context.Context, req resource.UpdateReque
 		return
 	}
 
+	ctx, cancel := operationContext(ctx, plan.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
 	var state ruleProxyResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	plan.Uuid = state.Uuid
@@ -478,6 +470,9 @@ func (r *ruleProxyResource) Delete(ctx context.Context, req resource.DeleteReque
 		return
 	}
 
+	ctx, cancel := operationContext(ctx, data.Timeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	// Delete API call logic
 	resp.Diagnostics.Append(callRuleProxyDeleteAPI(ctx, r, &data)...)
 }
@@ -502,83 +497,30 @@ func (r *ruleProxyResource) ImportState(ctx context.Context, req resource.Import
 		return
 	}
 
+	if data.RuleId.IsNull() {
+		resp.Diagnostics.AddError(
+			"Unable to import rule",
+			fmt.Sprintf("No rule %s was found in the project %s.", req.ID, data.Project.ValueString()),
+		)
+		return
+	}
+
+	// An imported resource has no timeouts block.
+	resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("timeouts"), &data.Timeouts)...)
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func callRuleProxyCreateAPI(ctx context.Context, r *ruleProxyResource, data *ruleProxyResourceModel) (diags diag.Diagnostics) {
 	req := *openapi.NewRuleProxyRequestWithDefaults()
-	req.SetName(data.Name.ValueString())
-
-	var domains []string
-	for _, domain := range data.Domain.Elements() {
-		if strVal, ok := domain.(types.String); ok {
-			domains = append(domains, strVal.ValueString())
-		}
-	}
-	req.SetDomain(domains)
-
-	var urls []string
-	for _, url := range data.Url.Elements() {
-		if strVal, ok := url.(types.String); ok {
-			urls = append(urls, strVal.ValueString())
-		}
-	}
-	req.SetUrl(urls)
-
-	req.SetCountry(data.Country.ValueString())
-	var countryList []string
-	if data.Country.ValueString() == "country_is" {
-		for _, country := range data.CountryIs.Elements() {
-			if strVal, ok := country.(types.String); ok {
-				countryList = append(countryList, strVal.ValueString())
-			}
-		}
-		req.SetCountryIs(countryList)
-	} else if data.Country.ValueString() == "country_is_not" {
-		for _, country := range data.CountryIsNot.Elements() {
-			if strVal, ok := country.(types.String); ok {
-				countryList = append(countryList, strVal.ValueString())
-			}
-		}
-		req.SetCountryIsNot(countryList)
-	}
 
-	req.SetIp(data.Ip.ValueString())
-	var iplist []string
-
-	if data.Ip.ValueString() == "ip_is" {
-		for _, ip := range data.IpIs.Elements() {
-			if strVal, ok := ip.(types.String); ok {
-				iplist = append(iplist, strVal.ValueString())
-			}
-		}
-		req.SetIpIs(iplist)
-	} else if data.Ip.ValueString() == "ip_is_not" {
-		for _, ip := range data.IpIsNot.Elements() {
-			if strVal, ok := ip.(types.String); ok {
-				iplist = append(iplist, strVal.ValueString())
-			}
-		}
-		req.SetIpIsNot(iplist)
+	diags.Append(setRuleRequest(ctx, &data.ruleBaseModel, &req)...)
+	if diags.HasError() {
+		return
 	}
 
-	req.SetMethod(data.Method.ValueString())
-	var methodList []string
-
-	if data.Method.ValueString() == "method_is" {
-		for _, method := range data.MethodIs.Elements() {
-			if strVal, ok := method.(types.String); ok {
-				methodList = append(methodList, strVal.ValueString())
-			}
-		}
-		req.SetMethodIs(methodList)
-	} else if data.Method.ValueString() == "method_is_not" {
-		for _, method := range data.MethodIsNot.Elements() {
-			if strVal, ok := method.(types.String); ok {
-				methodList = append(methodList, strVal.ValueString())
-			}
-		}
-		req.SetMethodIsNot(methodList)
+	if !data.CookieName.IsNull() && !data.CookieName.IsUnknown() {
+		req.SetCookieName(data.CookieName.ValueString())
 	}
 
 	// The proxy location.
@@ -672,14 +614,14 @@ func callRuleProxyCreateAPI(ctx context.Context, r *ruleProxyResource, data *rul
 			emails = append(emails, e.ValueString())
 		}
 	}
-    wafConfig.SetNotifyEmail(emails)
+	wafConfig.SetNotifyEmail(emails)
 
 	req.SetWafConfig(*wafConfig)
 
-	api, _, err := r.client.Instance.RulesProxyAPI.RulesProxyCreate(r.client.AuthContext, r.client.Organization, data.Project.ValueString()).RuleProxyRequest(req).Execute()
+	api, res, err := r.client.Instance.RulesProxyAPI.RulesProxyCreate(r.client.RequestContext(ctx), ruleOrganization(r.client, &data.ruleBaseModel), data.Project.ValueString()).RuleProxyRequest(req).Execute()
 
 	if err != nil {
-		diags.AddError("Failed to create rule proxy", err.Error())
+		diags.Append(apiErrorDiagnostics("Failed to create rule proxy", res, err, ruleProxyAPIFields)...)
 		return
 	}
 
@@ -700,79 +642,16 @@ func callRuleProxyUpdateAPI(ctx context.Context, r *ruleProxyResource, data *rul
 		return
 	}
 
-	org := r.client.Organization
+	org := ruleOrganization(r.client, &data.ruleBaseModel)
 	req := *openapi.NewRuleProxyRequestUpdateWithDefaults()
 
-	var domains []string
-	for _, domain := range data.Domain.Elements() {
-		if strVal, ok := domain.(types.String); ok {
-			domains = append(domains, strVal.ValueString())
-		}
-	}
-	req.SetDomain(domains)
-
-	var urls []string
-	for _, url := range data.Url.Elements() {
-		if strVal, ok := url.(types.String); ok {
-			urls = append(urls, strVal.ValueString())
-		}
-	}
-	req.SetUrl(urls)
-
-	req.SetCountry(data.Country.ValueString())
-	var countryList []string
-	if data.Country.ValueString() == "country_is" {
-		for _, country := range data.CountryIs.Elements() {
-			if strVal, ok := country.(types.String); ok {
-				countryList = append(countryList, strVal.ValueString())
-			}
-		}
-		req.SetCountryIs(countryList)
-	} else if data.Country.ValueString() == "country_is_not" {
-		for _, country := range data.CountryIsNot.Elements() {
-			if strVal, ok := country.(types.String); ok {
-				countryList = append(countryList, strVal.ValueString())
-			}
-		}
-		req.SetCountryIsNot(countryList)
-	}
-
-	req.SetIp(data.Ip.ValueString())
-	var iplist []string
-
-	if data.Ip.ValueString() == "ip_is" {
-		for _, ip := range data.IpIs.Elements() {
-			if strVal, ok := ip.(types.String); ok {
-				iplist = append(iplist, strVal.ValueString())
-			}
-		}
-		req.SetIpIs(iplist)
-	} else if data.Ip.ValueString() == "ip_is_not" {
-		for _, ip := range data.IpIsNot.Elements() {
-			if strVal, ok := ip.(types.String); ok {
-				iplist = append(iplist, strVal.ValueString())
-			}
-		}
-		req.SetIpIsNot(iplist)
+	diags.Append(setRuleRequest(ctx, &data.ruleBaseModel, &req)...)
+	if diags.HasError() {
+		return
 	}
 
-	req.SetMethod(data.Method.ValueString())
-	var methodList []string
-
-	if data.Method.ValueString() == "method_is" {
-		for _, method := range data.MethodIs.Elements() {
-			if strVal, ok := method.(types.String); ok {
-				methodList = append(methodList, strVal.ValueString())
-			}
-		}
-		req.SetMethodIs(methodList)
-	} else if data.Method.ValueString() == "method_is_not" {
-		for _, method := range data.MethodIsNot.Elements() {
-			if strVal, ok := method.(types.String); ok {
-				methodList = append(methodList, strVal.ValueString())
-			}
-		}
-		req.SetMethodIsNot(methodList)
+	if !data.CookieName.IsNull() && !data.CookieName.IsUnknown() {
+		req.SetCookieName(data.CookieName.ValueString())
 	}
 
 	// The proxy location.
@@ -869,10 +748,10 @@ func callRuleProxyUpdateAPI(ctx context.Context, r *ruleProxyResource, data *rul
 	wafConfig.SetNotifyEmail(emails)
 	req.SetWafConfig(*wafConfig)
 
-	_, _, err := r.client.Instance.RulesProxyAPI.RulesProxyUpdate(r.client.AuthContext, org, data.Project.ValueString(), data.RuleId.ValueString()).RuleProxyRequestUpdate(req).Execute()
+	_, res, err := r.client.Instance.RulesProxyAPI.RulesProxyUpdate(r.client.RequestContext(ctx), org, data.Project.ValueString(), data.RuleId.ValueString()).RuleProxyRequestUpdate(req).Execute()
 
 	if err != nil {
-		diags.AddError("Failed to update rule proxy", err.Error())
+		diags.Append(apiErrorDiagnostics("Failed to update rule proxy", res, err, ruleProxyAPIFields)...)
 		return
 	}
 
@@ -889,11 +768,11 @@ func callRuleProxyDeleteAPI(ctx context.Context, r *ruleProxyResource, rule *rul
 		return
 	}
 
-	org := r.client.Organization
-	_, _, err := r.client.Instance.RulesProxyAPI.RulesProxyDelete(r.client.AuthContext, org, rule.Project.ValueString(), rule.RuleId.ValueString()).Execute()
+	org := ruleOrganization(r.client, &rule.ruleBaseModel)
+	_, res, err := r.client.Instance.RulesProxyAPI.RulesProxyDelete(r.client.RequestContext(ctx), org, rule.Project.ValueString(), rule.RuleId.ValueString()).Execute()
 
-	if err != nil {
-		diags.AddError("Failed to delete rule proxy", err.Error())
+	if err != nil && !utils.IsNotFound(res) {
+		diags.Append(apiErrorDiagnostics("Failed to delete rule proxy", res, err, nil)...)
 		return
 	}
 
@@ -910,69 +789,34 @@ func callRuleProxyReadAPI(ctx context.Context, r *ruleProxyResource, rule *ruleP
 		return
 	}
 
-	org := r.client.Organization
-	api, _, err := r.client.Instance.RulesProxyAPI.RulesProxyRead(r.client.AuthContext, org, rule.Project.ValueString(), rule.RuleId.ValueString()).Execute()
+	org := ruleOrganization(r.client, &rule.ruleBaseModel)
+	api, res, err := r.client.Instance.RulesProxyAPI.RulesProxyRead(r.client.RequestContext(ctx), org, rule.Project.ValueString(), rule.RuleId.ValueString()).Execute()
 
-	if err != nil {
-		diags.AddWarning("Details: ", fmt.Sprintf("Org: %v, Project: %v, Uuid: %v", org, rule.Project.ValueString(), rule.RuleId.ValueString()))
-		diags.AddError("Failed to read rule", err.Error())
+	if utils.IsNotFound(res) {
+		// Signal to the caller that the rule no longer exists.
+		rule.RuleId = types.StringNull()
 		return
 	}
 
-	rule.Name = types.StringValue(api.GetName())
-	rule.Uuid = types.StringValue(api.GetUuid())
-	domains, d := types.ListValueFrom(ctx, types.StringType, api.GetDomain())
-	if d.HasError() {
-		diags.Append(d...)
-		return
-	}
-	rule.Domain = domains
-	urls, d := types.ListValueFrom(ctx, types.StringType, api.GetUrl())
-	if d.HasError() {
-		diags.Append(d...)
-		return
-	}
-	rule.Url = urls
-	rule.Ip = types.StringValue(api.GetIp())
-	ips, d := types.ListValueFrom(ctx, types.StringType, api.GetIpIs())
-	if d.HasError() {
-		diags.Append(d...)
-		return
-	}
-	rule.IpIs = types.List(ips)
-	ipIsNot, d := types.ListValueFrom(ctx, types.StringType, api.GetIpIsNot())
-	if d.HasError() {
-		diags.Append(d...)
-		return
-	}
-	rule.IpIsNot = types.List(ipIsNot)
-	rule.Country = types.StringValue(api.GetCountry())
-	countries, d := types.ListValueFrom(ctx, types.StringType, api.GetCountryIs())
-	if d.HasError() {
-		diags.Append(d...)
+	if err != nil {
+		diags.Append(apiErrorDiagnostics("Failed to read rule", res, err, nil)...)
 		return
 	}
-	rule.CountryIs = types.List(countries)
-	rule.CountryIsNot, d = types.ListValueFrom(ctx, types.StringType, api.GetCountryIsNot())
-	if d.HasError() {
-		diags.Append(d...)
+
+	prior := rule.ruleBaseModel
+
+	diags.Append(setRuleModel(ctx, &rule.ruleBaseModel, api)...)
+	if diags.HasError() {
 		return
 	}
-	rule.CountryIsNot = types.List(rule.CountryIsNot)
 
-	rule.Method = types.StringValue(api.GetMethod())
-	methods, d := types.ListValueFrom(ctx, types.StringType, api.GetMethodIs())
-	if d.HasError() {
-		diags.Append(d...)
-		return
+	// Leave the API defaults out of state when they were not configured.
+	if prior.Domain.IsNull() && len(api.GetDomain()) == 1 && api.GetDomain()[0] == *utils.GetRuleAny() {
+		rule.Domain = types.ListNull(types.StringType)
 	}
-	rule.MethodIs = types.List(methods)
-	methodIsNot, d := types.ListValueFrom(ctx, types.StringType, api.GetMethodIsNot())
-	if d.HasError() {
-		diags.Append(d...)
-		return
+	if prior.Disabled.IsNull() && !api.GetDisabled() {
+		rule.Disabled = types.BoolNull()
 	}
-	rule.MethodIsNot = types.List(methodIsNot)
 
 	// Rule specific fields.
 	actionConfig, ok := api.GetActionConfigOk()
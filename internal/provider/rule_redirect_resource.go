@@ -22,8 +22,18 @@ var (
 	_ resource.ResourceWithConfigure        = (*ruleRedirectResource)(nil)
 	_ resource.ResourceWithImportState      = (*ruleRedirectResource)(nil)
 	_ resource.ResourceWithConfigValidators = (*ruleRedirectResource)(nil)
+	_ resource.ResourceWithModifyPlan       = (*ruleRedirectResource)(nil)
 )
 
+// ruleRedirectAPIFields maps redirect rule request fields to their
+// attributes, the API reports errors on the stored "to" and "status_code".
+var ruleRedirectAPIFields = ruleAPIFields(apiFields{
+	"redirect_to":   "redirect_to",
+	"redirect_code": "redirect_code",
+	"to":            "redirect_to",
+	"status_code":   "redirect_code",
+})
+
 func NewRuleRedirectResource() resource.Resource {
 	return &ruleRedirectResource{}
 }
@@ -33,24 +43,7 @@ type ruleRedirectResource struct {
 }
 
 type ruleRedirectResourceModel struct {
-	Project        types.String `tfsdk:"project"`
-	Organization   types.String `tfsdk:"organization"`
-	Name           types.String `tfsdk:"name"`
-	Uuid           types.String `tfsdk:"uuid"`
-	RuleId         types.String `tfsdk:"rule_id"`
-	Url            types.List   `tfsdk:"url"`
-	Domain         types.List   `tfsdk:"domain"`
-	Disabled       types.Bool   `tfsdk:"disabled"`
-	OnlyWithCookie types.Bool   `tfsdk:"only_with_cookie"`
-	Method         types.String `tfsdk:"method"`
-	MethodIs       types.List   `tfsdk:"method_is"`
-	MethodIsNot    types.List   `tfsdk:"method_is_not"`
-	Ip             types.String `tfsdk:"ip"`
-	IpIs           types.List   `tfsdk:"ip_is"`
-	IpIsNot        types.List   `tfsdk:"ip_is_not"`
-	Country        types.String `tfsdk:"country"`
-	CountryIs      types.List   `tfsdk:"country_is"`
-	CountryIsNot   types.List   `tfsdk:"country_is_not"`
+	ruleBaseModel
 
 	// Rule specific details.
 	RedirectTo   types.String `tfsdk:"redirect_to"`
@@ -77,7 +70,11 @@ func (r *ruleRedirectResource) Schema(ctx context.Context, req resource.SchemaRe
 	}
 
 	// Set the attributes for this rule.
-	resp.Schema = schema.Schema{Attributes: attributes}
+	resp.Schema = schema.Schema{Attributes: attributes, Blocks: timeoutsBlocks(ctx)}
+}
+
+func (r *ruleRedirectResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyPlanProject(ctx, r.client, req, resp)
 }
 
 func (r *ruleRedirectResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
@@ -108,6 +105,9 @@ func (r *ruleRedirectResource) Create(ctx context.Context, req resource.CreateRe
 		return
 	}
 
+	ctx, cancel := operationContext(ctx, data.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
 	// Create API call logic
 	resp.Diagnostics.Append(callRuleRedirectCreateAPI(ctx, r, &data)...)
 
@@ -115,6 +115,21 @@ func (r *ruleRedirectResource) Create(ctx context.Context, req resource.CreateRe
 		return
 	}
 
+	// Read the API defaults and normalised values back into the model.
+	resp.Diagnostics.Append(callRuleRedirectReadAPI(ctx, r, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.RuleId.IsNull() {
+		resp.Diagnostics.AddError(
+			"Failed to read rule",
+			"The redirect rule was not found after it was created.",
+		)
+		return
+	}
+
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -129,9 +144,22 @@ func (r *ruleRedirectResource) Read(ctx context.Context, req resource.ReadReques
 		return
 	}
 
+	ctx, cancel := operationContext(ctx, data.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
 	// Read API call logic
 	resp.Diagnostics.Append(callRuleRedirectReadAPI(ctx, r, &data)...)
 
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The rule was removed outside of Terraform.
+	if data.RuleId.IsNull() {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -146,6 +174,9 @@ func (r *ruleRedirectResource) Update(ctx context.Context, req resource.UpdateRe
 		return
 	}
 
+	ctx, cancel := operationContext(ctx, plan.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
 	var state ruleRedirectResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	plan.RuleId = state.RuleId
@@ -159,6 +190,18 @@ func (r *ruleRedirectResource) Update(ctx context.Context, req resource.UpdateRe
 
 	resp.Diagnostics.Append(callRuleRedirectReadAPI(ctx, r, &plan)...)
 
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.RuleId.IsNull() {
+		resp.Diagnostics.AddError(
+			"Failed to read rule",
+			"The redirect rule was removed while it was being updated.",
+		)
+		return
+	}
+
 	// Save updated plan into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
@@ -173,6 +216,9 @@ func (r *ruleRedirectResource) Delete(ctx context.Context, req resource.DeleteRe
 		return
 	}
 
+	ctx, cancel := operationContext(ctx, data.Timeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	// Delete API call logic
 	resp.Diagnostics.Append(callRuleRedirectDeleteAPI(ctx, r, &data)...)
 }
@@ -197,6 +243,17 @@ func (r *ruleRedirectResource) ImportState(ctx context.Context, req resource.Imp
 		return
 	}
 
+	if data.RuleId.IsNull() {
+		resp.Diagnostics.AddError(
+			"Unable to import rule",
+			fmt.Sprintf("No rule %s was found in the project %s.", req.ID, data.Project.ValueString()),
+		)
+		return
+	}
+
+	// An imported resource has no timeouts block.
+	resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("timeouts"), &data.Timeouts)...)
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -204,92 +261,19 @@ func (r *ruleRedirectResource) ImportState(ctx context.Context, req resource.Imp
 // resource in Quant.
 func callRuleRedirectCreateAPI(ctx context.Context, r *ruleRedirectResource, rule *ruleRedirectResourceModel) (diags diag.Diagnostics) {
 	req := *openapi.NewRuleRedirectRequestWithDefaults()
-	req.SetName(rule.Name.ValueString())
-
-	var domains []string
-	for _, domain := range rule.Domain.Elements() {
-		if strVal, ok := domain.(types.String); ok {
-			domains = append(domains, strVal.ValueString())
-		}
-	}
-	req.SetDomain(domains)
-
-	var urls []string
-	for _, url := range rule.Url.Elements() {
-		if strVal, ok := url.(types.String); ok {
-			urls = append(urls, strVal.ValueString())
-		}
-	}
-	req.SetUrl(urls)
-
-	if !rule.Country.IsNull() {
-		req.SetCountry(rule.Country.ValueString())
-		var countryList []string
-		if rule.Country.ValueString() == "country_is" {
-			for _, country := range rule.CountryIs.Elements() {
-				if strVal, ok := country.(types.String); ok {
-					countryList = append(countryList, strVal.ValueString())
-				}
-			}
-			req.SetCountryIs(countryList)
-		} else if rule.Country.ValueString() == "country_is_not" {
-			for _, country := range rule.CountryIsNot.Elements() {
-				if strVal, ok := country.(types.String); ok {
-					countryList = append(countryList, strVal.ValueString())
-				}
-			}
-			req.SetCountryIsNot(countryList)
-		}
-	}
-
-	if (!rule.Ip.IsNull()) {
-		req.SetIp(rule.Ip.ValueString())
-		var iplist []string
-		if rule.Ip.ValueString() == "ip_is" {
-			for _, ip := range rule.IpIs.Elements() {
-				if strVal, ok := ip.(types.String); ok {
-					iplist = append(iplist, strVal.ValueString())
-				}
-			}
-			req.SetIpIs(iplist)
-		} else if rule.Ip.ValueString() == "ip_is_not" {
-			for _, ip := range rule.IpIsNot.Elements() {
-				if strVal, ok := ip.(types.String); ok {
-					iplist = append(iplist, strVal.ValueString())
-				}
-			}
-			req.SetIpIsNot(iplist)
-		}
-	}
-
 
-	if (!rule.Method.IsNull()) {
-		req.SetMethod(rule.Method.ValueString())
-		var methodList []string
-		if rule.Method.ValueString() == "method_is" {
-			for _, method := range rule.MethodIs.Elements() {
-				if strVal, ok := method.(types.String); ok {
-					methodList = append(methodList, strVal.ValueString())
-				}
-			}
-			req.SetMethodIs(methodList)
-		} else if rule.Method.ValueString() == "method_is_not" {
-			for _, method := range rule.MethodIsNot.Elements() {
-				if strVal, ok := method.(types.String); ok {
-					methodList = append(methodList, strVal.ValueString())
-				}
-			}
-			req.SetMethodIsNot(methodList)
-		}
+	diags.Append(setRuleRequest(ctx, &rule.ruleBaseModel, &req)...)
+	if diags.HasError() {
+		return
 	}
 
 	req.SetRedirectCode(rule.RedirectCode.ValueString())
 	req.SetRedirectTo(rule.RedirectTo.ValueString())
 
-	res, _, err := r.client.Instance.RulesRedirectAPI.RulesRedirectCreate(r.client.AuthContext, r.client.Organization, rule.Project.ValueString()).RuleRedirectRequest(req).Execute()
+	res, httpRes, err := r.client.Instance.RulesRedirectAPI.RulesRedirectCreate(r.client.RequestContext(ctx), ruleOrganization(r.client, &rule.ruleBaseModel), rule.Project.ValueString()).RuleRedirectRequest(req).Execute()
 
 	if err != nil {
-		diags.AddError("Failed to create rule", err.Error())
+		diags.Append(apiErrorDiagnostics("Failed to create rule", httpRes, err, ruleRedirectAPIFields)...)
 		return
 	}
 
@@ -299,7 +283,6 @@ func callRuleRedirectCreateAPI(ctx context.Context, r *ruleRedirectResource, rul
 	return
 }
 
-
 // callRuleRedirectReadAPI
 func callRuleRedirectReadAPI(ctx context.Context, r *ruleRedirectResource, rule *ruleRedirectResourceModel) (diags diag.Diagnostics) {
 	if rule.RuleId.IsNull() || rule.RuleId.IsUnknown() {
@@ -311,72 +294,28 @@ func callRuleRedirectReadAPI(ctx context.Context, r *ruleRedirectResource, rule
 		return
 	}
 
-	api, res, err := r.client.Instance.RulesRedirectAPI.RulesRedirectRead(r.client.AuthContext, r.client.Organization, rule.Project.ValueString(), rule.RuleId.ValueString()).Execute()
+	api, res, err := r.client.Instance.RulesRedirectAPI.RulesRedirectRead(r.client.RequestContext(ctx), ruleOrganization(r.client, &rule.ruleBaseModel), rule.Project.ValueString(), rule.RuleId.ValueString()).Execute()
 
-	if err != nil {
-		diags.AddError("Failed to read rule", err.Error())
-		diags.AddError("Response", fmt.Sprintf("%v", res))
+	if utils.IsNotFound(res) {
+		// Signal to the caller that the rule no longer exists.
+		rule.RuleId = types.StringNull()
 		return
 	}
 
-	rule.Name = types.StringValue(*api.Name)
-	rule.Uuid = types.StringValue(api.Uuid)
-	domains, d := types.ListValueFrom(ctx, types.StringType, api.Domain)
-	if d.HasError() {
-		diags.Append(d...)
-		return
-	}
-	rule.Domain = domains
-	urls, d := types.ListValueFrom(ctx, types.StringType, api.Url)
-	if d.HasError() {
-		diags.Append(d...)
-		return
-	}
-	rule.Url = urls
-	rule.Ip = types.StringValue(*api.Ip)
-	ips, d := types.ListValueFrom(ctx, types.StringType, api.IpIs)
-	if d.HasError() {
-		diags.Append(d...)
-		return
-	}
-	rule.IpIs = types.List(ips)
-	ipIsNot, d := types.ListValueFrom(ctx, types.StringType, api.IpIsNot)
-	if d.HasError() {
-		diags.Append(d...)
-		return
-	}
-	rule.IpIsNot = types.List(ipIsNot)
-	rule.Country = types.StringValue(*api.Country)
-	countries, d := types.ListValueFrom(ctx, types.StringType, api.CountryIs)
-	if d.HasError() {
-		diags.Append(d...)
-		return
-	}
-	rule.CountryIs = types.List(countries)
-	rule.CountryIsNot, d = types.ListValueFrom(ctx, types.StringType, api.CountryIsNot)
-	if d.HasError() {
-		diags.Append(d...)
+	if err != nil {
+		diags.Append(apiErrorDiagnostics("Failed to read rule", res, err, nil)...)
 		return
 	}
-	rule.CountryIsNot = types.List(rule.CountryIsNot)
 
-	rule.Method = types.StringValue(*api.Method)
-	methods, d := types.ListValueFrom(ctx, types.StringType, api.MethodIs)
-	if d.HasError() {
-		diags.Append(d...)
+	diags.Append(setRuleModel(ctx, &rule.ruleBaseModel, api)...)
+	if diags.HasError() {
 		return
 	}
-	rule.MethodIs = types.List(methods)
-	methodIsNot, d := types.ListValueFrom(ctx, types.StringType, api.MethodIsNot)
-	if d.HasError() {
-		diags.Append(d...)
-		return
-	}
-	rule.MethodIsNot = types.List(methodIsNot)
 
 	// Rule specific fields.
-	rule.RedirectCode = types.StringValue(api.ActionConfig.StatusCode)
-	rule.RedirectTo = types.StringValue(api.ActionConfig.To)
+	actionConfig := api.GetActionConfig()
+	rule.RedirectCode = types.StringValue(actionConfig.StatusCode)
+	rule.RedirectTo = types.StringValue(actionConfig.To)
 
 	return
 }
@@ -393,81 +332,19 @@ func callRuleRedirectUpdateAPI(ctx context.Context, r *ruleRedirectResource, rul
 	}
 
 	req := *openapi.NewRuleRedirectRequestUpdateWithDefaults()
-	req.SetName(rule.Name.ValueString())
 
-	var domains []string
-	for _, domain := range rule.Domain.Elements() {
-		if strVal, ok := domain.(types.String); ok {
-			domains = append(domains, strVal.ValueString())
-		}
-	}
-	req.SetDomain(domains)
-
-	var urls []string
-	for _, url := range rule.Url.Elements() {
-		if strVal, ok := url.(types.String); ok {
-			urls = append(urls, strVal.ValueString())
-		}
-	}
-	req.SetUrl(urls)
-
-	req.SetCountry(rule.Country.ValueString())
-	var countryList []string
-	for _, country := range rule.CountryIs.Elements() {
-		if strVal, ok := country.(types.String); ok {
-			countryList = append(countryList, strVal.ValueString())
-		}
-	}
-	req.SetCountryIs(countryList)
-	countryList = []string{}
-	for _, country := range rule.CountryIsNot.Elements() {
-		if strVal, ok := country.(types.String); ok {
-			countryList = append(countryList, strVal.ValueString())
-		}
-	}
-	req.SetCountryIsNot(countryList)
-
-	req.SetIp(rule.Ip.ValueString())
-	var iplist []string
-	for _, ip := range rule.IpIs.Elements() {
-		if strVal, ok := ip.(types.String); ok {
-			iplist = append(iplist, strVal.ValueString())
-		}
-	}
-	req.SetIpIs(iplist)
-	iplist = []string{}
-	for _, ip := range rule.IpIsNot.Elements() {
-		if strVal, ok := ip.(types.String); ok {
-			iplist = append(iplist, strVal.ValueString())
-		}
-	}
-	req.SetIpIsNot(iplist)
-
-	req.SetMethod(rule.Method.ValueString())
-	var methodList []string
-	for _, method := range rule.MethodIs.Elements() {
-		if strVal, ok := method.(types.String); ok {
-			methodList = append(methodList, strVal.ValueString())
-		}
-	}
-	req.SetMethodIs(methodList)
-	methodList = []string{}
-	for _, method := range rule.MethodIsNot.Elements() {
-		if strVal, ok := method.(types.String); ok {
-			methodList = append(methodList, strVal.ValueString())
-		}
+	diags.Append(setRuleRequest(ctx, &rule.ruleBaseModel, &req)...)
+	if diags.HasError() {
+		return
 	}
-	req.SetMethodIsNot(methodList)
-
 
 	req.SetRedirectCode(rule.RedirectCode.ValueString())
 	req.SetRedirectTo(rule.RedirectTo.ValueString())
 
-	_, res, err := r.client.Instance.RulesRedirectAPI.RulesRedirectUpdate(r.client.AuthContext, r.client.Organization, rule.Project.ValueString(), rule.RuleId.ValueString()).RuleRedirectRequestUpdate(req).Execute()
+	_, res, err := r.client.Instance.RulesRedirectAPI.RulesRedirectUpdate(r.client.RequestContext(ctx), ruleOrganization(r.client, &rule.ruleBaseModel), rule.Project.ValueString(), rule.RuleId.ValueString()).RuleRedirectRequestUpdate(req).Execute()
 
 	if err != nil {
-		diags.AddError("Failed to update rule", err.Error())
-		diags.AddError("Response", fmt.Sprintf("%v", res))
+		diags.Append(apiErrorDiagnostics("Failed to update rule", res, err, ruleRedirectAPIFields)...)
 		return
 	}
 
@@ -482,13 +359,14 @@ func callRuleRedirectDeleteAPI(ctx context.Context, r *ruleRedirectResource, rul
 			"Missing rule.uuid attribute",
 			"Unable to delete unknown rule, please update terraform state.",
 		)
+		return
 	}
 
-	org := r.client.Organization
-	_, _, err := r.client.Instance.RulesRedirectAPI.RulesRedirectDelete(r.client.AuthContext, org, rule.Project.ValueString(), rule.RuleId.ValueString()).Execute()
+	org := ruleOrganization(r.client, &rule.ruleBaseModel)
+	_, res, err := r.client.Instance.RulesRedirectAPI.RulesRedirectDelete(r.client.RequestContext(ctx), org, rule.Project.ValueString(), rule.RuleId.ValueString()).Execute()
 
-	if err != nil {
-		diags.AddError("Failed to delete rule", err.Error())
+	if err != nil && !utils.IsNotFound(res) {
+		diags.Append(apiErrorDiagnostics("Failed to delete rule", res, err, nil)...)
 		return
 	}
 
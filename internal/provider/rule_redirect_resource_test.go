@@ -2,10 +2,15 @@ package provider_test
 
 import (
 	"context"
+	"fmt"
 	"os"
-
+	"regexp"
+	"terraform-provider-quant/internal/client"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	openapi "github.com/quantcdn/quant-admin-go"
 	"github.com/stretchr/testify/assert"
 )
@@ -20,3 +25,144 @@ func TestListRedirectResource(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Equal(t, 4, len(rules))
 }
+
+func TestAccRuleRedirectResource(t *testing.T) {
+	api := newFakeAPI(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(api) + `
+resource "quant_rule_redirect" "test" {
+  project       = "api-test"
+  name          = "old blog"
+  url           = ["/blog/*"]
+  redirect_to   = "https://example.com/news"
+  redirect_code = "301"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("quant_rule_redirect.test", "uuid"),
+					resource.TestCheckResourceAttr("quant_rule_redirect.test", "redirect_to", "https://example.com/news"),
+					resource.TestCheckResourceAttr("quant_rule_redirect.test", "domain.0", "any"),
+				),
+			},
+			{
+				Config: testAccProviderConfig(api) + `
+resource "quant_rule_redirect" "test" {
+  project       = "api-test"
+  name          = "old blog"
+  url           = ["/blog/*"]
+  redirect_to   = "https://example.com/articles"
+  redirect_code = "302"
+  country       = "country_is"
+  country_is    = ["AU", "NZ"]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("quant_rule_redirect.test", "redirect_code", "302"),
+					resource.TestCheckResourceAttr("quant_rule_redirect.test", "country_is.#", "2"),
+				),
+			},
+			{
+				ResourceName:                         "quant_rule_redirect.test",
+				ImportState:                          true,
+				ImportStateIdFunc:                    testAccRuleImportId("quant_rule_redirect.test"),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "uuid",
+				ImportStateVerifyIgnore: []string{
+					"organization",
+				},
+			},
+		},
+	})
+}
+
+func TestAccRuleRedirectResourceDisappears(t *testing.T) {
+	api := newFakeAPI(t)
+	config := testAccProviderConfig(api) + `
+resource "quant_rule_redirect" "test" {
+  project       = "api-test"
+  name          = "gone"
+  url           = ["/gone"]
+  redirect_to   = "https://example.com/"
+  redirect_code = "301"
+}
+`
+
+	var uuid string
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: func(s *terraform.State) error {
+					uuid = s.RootModule().Resources["quant_rule_redirect.test"].Primary.Attributes["uuid"]
+					return nil
+				},
+			},
+			{
+				// Deleting the rule outside of Terraform plans it for creation
+				// instead of failing the refresh.
+				PreConfig: func() {
+					c := client.New(context.Background(), "test-token", "quant", client.Options{Endpoint: api.URL})
+					_, err := c.RulesDelete(c.AuthContext, "quant", "api-test", "redirect", uuid)
+					assert.Nil(t, err)
+				},
+				Config:             config,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccRuleRedirectResourceOrganization(t *testing.T) {
+	api := newFakeAPI(t)
+	config := func(organization string) string {
+		return testAccProviderConfig(api) + fmt.Sprintf(`
+resource "quant_rule_redirect" "test" {
+  organization  = %q
+  project       = "api-test"
+  name          = "client"
+  url           = ["/client"]
+  redirect_to   = "https://example.com/"
+  redirect_code = "301"
+}
+`, organization)
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config("client-a"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("quant_rule_redirect.test", "organization", "client-a"),
+					func(*terraform.State) error {
+						api.mu.Lock()
+						defer api.mu.Unlock()
+						if !api.orgs["client-a"] {
+							return fmt.Errorf("no request was sent to the client-a organization")
+						}
+						return nil
+					},
+				),
+			},
+			{
+				Config: config("client-b"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("quant_rule_redirect.test", plancheck.ResourceActionReplace),
+					},
+				},
+				Check: resource.TestCheckResourceAttr("quant_rule_redirect.test", "organization", "client-b"),
+			},
+			{
+				Config:      config("Client B"),
+				ExpectError: regexp.MustCompile(`Must be an organization machine name`),
+			},
+		},
+	})
+}
@@ -2,8 +2,15 @@ package provider
 
 import (
 	"context"
+	"fmt"
+	"strconv"
+	"terraform-provider-quant/internal/client"
+	"terraform-provider-quant/internal/utils"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
@@ -15,7 +22,11 @@ import (
 // RuleBaseConfigValidator defines the common validation processes
 // for each rule provider.
 func RuleBaseConfigValidator() []resource.ConfigValidator {
-	return []resource.ConfigValidator{}
+	return []resource.ConfigValidator{
+		ruleCriteriaValidator{filter: "method"},
+		ruleCriteriaValidator{filter: "ip"},
+		ruleCriteriaValidator{filter: "country"},
+	}
 }
 
 // RuleBaseAttributes defines the base rule attributes for the provider
@@ -25,12 +36,8 @@ func RuleBaseConfigValidator() []resource.ConfigValidator {
 func RuleBaseAttributes(ctx context.Context) map[string]schema.Attribute {
 	dominDefault, _ := types.ListValueFrom(ctx, types.StringType, []string{"any"})
 	return map[string]schema.Attribute{
-		"organization": schema.StringAttribute{
-			Optional: true,
-		},
-		"project": schema.StringAttribute{
-			Optional: true,
-		},
+		"organization": organizationAttribute(),
+		"project":      projectAttribute(),
 		"name": schema.StringAttribute{
 			Required: true,
 		},
@@ -102,3 +109,649 @@ func RuleBaseAttributes(ctx context.Context) map[string]schema.Attribute {
 		},
 	}
 }
+
+// ruleBaseModel holds the selection criteria from RuleBaseAttributes, rule
+// resource models embed it and only declare their action specific fields.
+type ruleBaseModel struct {
+	Project        types.String   `tfsdk:"project"`
+	Organization   types.String   `tfsdk:"organization"`
+	Name           types.String   `tfsdk:"name"`
+	Uuid           types.String   `tfsdk:"uuid"`
+	RuleId         types.String   `tfsdk:"rule_id"`
+	Url            types.List     `tfsdk:"url"`
+	Domain         types.List     `tfsdk:"domain"`
+	Disabled       types.Bool     `tfsdk:"disabled"`
+	OnlyWithCookie types.Bool     `tfsdk:"only_with_cookie"`
+	Method         types.String   `tfsdk:"method"`
+	MethodIs       types.List     `tfsdk:"method_is"`
+	MethodIsNot    types.List     `tfsdk:"method_is_not"`
+	Ip             types.String   `tfsdk:"ip"`
+	IpIs           types.List     `tfsdk:"ip_is"`
+	IpIsNot        types.List     `tfsdk:"ip_is_not"`
+	Country        types.String   `tfsdk:"country"`
+	CountryIs      types.List     `tfsdk:"country_is"`
+	CountryIsNot   types.List     `tfsdk:"country_is_not"`
+	Timeouts       timeouts.Value `tfsdk:"timeouts"`
+}
+
+// ruleRequest is satisfied by the create and update request models of every
+// rule type.
+type ruleRequest interface {
+	SetName(v string)
+	SetDomain(v []string)
+	SetUrl(v []string)
+	SetDisabled(v bool)
+	SetOnlyWithCookie(v bool)
+	SetMethod(v string)
+	SetMethodIs(v []string)
+	SetMethodIsNot(v []string)
+	SetIp(v string)
+	SetIpIs(v []string)
+	SetIpIsNot(v []string)
+	SetCountry(v string)
+	SetCountryIs(v []string)
+	SetCountryIsNot(v []string)
+}
+
+// ruleResponse is satisfied by the rule models returned by the API.
+type ruleResponse interface {
+	GetName() string
+	GetUuid() string
+	GetRuleId() string
+	GetUrl() []string
+	GetDomain() []string
+	GetDisabled() bool
+	GetOnlyWithCookie() string
+	GetMethod() string
+	GetMethodIs() []string
+	GetMethodIsNot() []string
+	GetIp() string
+	GetIpIs() []string
+	GetIpIsNot() []string
+	GetCountry() string
+	GetCountryIs() []string
+	GetCountryIsNot() []string
+}
+
+// setRuleRequest copies the selection criteria onto an API request. Only the
+// list matching each selector is sent, the other is cleared so values from a
+// previous selector do not linger on update.
+func setRuleRequest(ctx context.Context, rule *ruleBaseModel, req ruleRequest) (diags diag.Diagnostics) {
+	req.SetName(rule.Name.ValueString())
+	req.SetDomain(ruleListElements(ctx, rule.Domain, &diags))
+	req.SetUrl(ruleListElements(ctx, rule.Url, &diags))
+	req.SetDisabled(rule.Disabled.ValueBool())
+	req.SetOnlyWithCookie(rule.OnlyWithCookie.ValueBool())
+
+	method, methodIs, methodIsNot := ruleCriteria(ctx, "method", rule.Method, rule.MethodIs, rule.MethodIsNot, &diags)
+	req.SetMethod(method)
+	req.SetMethodIs(methodIs)
+	req.SetMethodIsNot(methodIsNot)
+
+	ip, ipIs, ipIsNot := ruleCriteria(ctx, "ip", rule.Ip, rule.IpIs, rule.IpIsNot, &diags)
+	req.SetIp(ip)
+	req.SetIpIs(ipIs)
+	req.SetIpIsNot(ipIsNot)
+
+	country, countryIs, countryIsNot := ruleCriteria(ctx, "country", rule.Country, rule.CountryIs, rule.CountryIsNot, &diags)
+	req.SetCountry(country)
+	req.SetCountryIs(countryIs)
+	req.SetCountryIsNot(countryIsNot)
+
+	return
+}
+
+// setRuleModel reads the selection criteria from an API response. Values the
+// API fills in by default ("any", empty lists) are kept null when they were
+// not configured so they do not show up as drift.
+func setRuleModel(ctx context.Context, rule *ruleBaseModel, api ruleResponse) (diags diag.Diagnostics) {
+	rule.Name = types.StringValue(api.GetName())
+	rule.Uuid = types.StringValue(api.GetUuid())
+	if api.GetRuleId() != "" {
+		rule.RuleId = types.StringValue(api.GetRuleId())
+	}
+
+	rule.Domain = ruleListValue(ctx, rule.Domain, api.GetDomain(), &diags)
+	rule.Url = ruleListValue(ctx, rule.Url, api.GetUrl(), &diags)
+	rule.Disabled = types.BoolValue(api.GetDisabled())
+
+	onlyWithCookie, _ := strconv.ParseBool(api.GetOnlyWithCookie())
+	if onlyWithCookie || (!rule.OnlyWithCookie.IsNull() && !rule.OnlyWithCookie.IsUnknown()) {
+		rule.OnlyWithCookie = types.BoolValue(onlyWithCookie)
+	} else {
+		rule.OnlyWithCookie = types.BoolNull()
+	}
+
+	rule.Method = ruleSelectorValue(rule.Method, api.GetMethod())
+	rule.MethodIs = ruleListValue(ctx, rule.MethodIs, api.GetMethodIs(), &diags)
+	rule.MethodIsNot = ruleListValue(ctx, rule.MethodIsNot, api.GetMethodIsNot(), &diags)
+
+	rule.Ip = ruleSelectorValue(rule.Ip, api.GetIp())
+	rule.IpIs = ruleListValue(ctx, rule.IpIs, api.GetIpIs(), &diags)
+	rule.IpIsNot = ruleListValue(ctx, rule.IpIsNot, api.GetIpIsNot(), &diags)
+
+	rule.Country = ruleSelectorValue(rule.Country, api.GetCountry())
+	rule.CountryIs = ruleListValue(ctx, rule.CountryIs, api.GetCountryIs(), &diags)
+	rule.CountryIsNot = ruleListValue(ctx, rule.CountryIsNot, api.GetCountryIsNot(), &diags)
+
+	return
+}
+
+// ruleOrganization returns the organization a rule belongs to, falling back
+// to the provider organization when the rule does not override it, and
+// records it in the model.
+func ruleOrganization(c *client.Client, rule *ruleBaseModel) string {
+	org := organizationValue(c, rule.Organization)
+	rule.Organization = types.StringValue(org)
+	return org
+}
+
+// ruleAPIFields maps the criteria request fields shared by every rule type,
+// and the action fields of one type, to their attributes.
+func ruleAPIFields(action apiFields) apiFields {
+	fields := apiFields{
+		"name":             "name",
+		"url":              "url",
+		"domain":           "domain",
+		"disabled":         "disabled",
+		"only_with_cookie": "only_with_cookie",
+		"method":           "method",
+		"method_is":        "method_is",
+		"method_is_not":    "method_is_not",
+		"ip":               "ip",
+		"ip_is":            "ip_is",
+		"ip_is_not":        "ip_is_not",
+		"country":          "country",
+		"country_is":       "country_is",
+		"country_is_not":   "country_is_not",
+	}
+	for field, attribute := range action {
+		fields[field] = attribute
+	}
+	return fields
+}
+
+// callRuleCreateAPI creates a rule of a type quant-admin-go does not model
+// yet, action holds the fields specific to that rule type and fields maps
+// them to attributes.
+func callRuleCreateAPI(ctx context.Context, c *client.Client, ruleType string, rule *ruleBaseModel, action map[string]interface{}, fields apiFields) (diags diag.Diagnostics) {
+	req := client.RuleRequest{Action: action}
+
+	diags.Append(setRuleRequest(ctx, rule, &req)...)
+	if diags.HasError() {
+		return
+	}
+
+	org := ruleOrganization(c, rule)
+	res, httpRes, err := c.RulesCreate(c.RequestContext(ctx), org, rule.Project.ValueString(), ruleType, req)
+
+	if err != nil {
+		diags.Append(apiErrorDiagnostics("Failed to create rule", httpRes, err, ruleAPIFields(fields))...)
+		return
+	}
+
+	rule.Uuid = types.StringValue(res.GetUuid())
+	rule.RuleId = types.StringValue(res.GetRuleId())
+	if res.GetRuleId() == "" {
+		rule.RuleId = rule.Uuid
+	}
+
+	return
+}
+
+// callRuleReadAPI loads a rule created with callRuleCreateAPI and decodes its
+// action configuration into action. RuleId is set to null when the rule no
+// longer exists.
+func callRuleReadAPI(ctx context.Context, c *client.Client, ruleType string, rule *ruleBaseModel, action interface{}) (diags diag.Diagnostics) {
+	if rule.RuleId.IsNull() || rule.RuleId.IsUnknown() {
+		diags.AddAttributeError(
+			path.Root("uuid"),
+			"Missing rule.uuid attribute",
+			"Unable to read unknown rule, please update terraform state.",
+		)
+		return
+	}
+
+	org := ruleOrganization(c, rule)
+	api, res, err := c.RulesRead(c.RequestContext(ctx), org, rule.Project.ValueString(), ruleType, rule.RuleId.ValueString())
+
+	if utils.IsNotFound(res) {
+		rule.RuleId = types.StringNull()
+		return
+	}
+
+	if err != nil {
+		diags.Append(apiErrorDiagnostics("Failed to read rule", res, err, nil)...)
+		return
+	}
+
+	diags.Append(setRuleModel(ctx, rule, api)...)
+	if diags.HasError() {
+		return
+	}
+
+	if err := api.DecodeActionConfig(action); err != nil {
+		diags.AddError("Failed to read rule action", err.Error())
+	}
+
+	return
+}
+
+// callRuleUpdateAPI updates a rule created with callRuleCreateAPI.
+func callRuleUpdateAPI(ctx context.Context, c *client.Client, ruleType string, rule *ruleBaseModel, action map[string]interface{}, fields apiFields) (diags diag.Diagnostics) {
+	if rule.RuleId.IsNull() || rule.RuleId.IsUnknown() {
+		diags.AddAttributeError(
+			path.Root("uuid"),
+			"Missing rule.uuid attribute",
+			"Unable to update unkown rule, please update terraform state.",
+		)
+		return
+	}
+
+	req := client.RuleRequest{Action: action}
+
+	diags.Append(setRuleRequest(ctx, rule, &req)...)
+	if diags.HasError() {
+		return
+	}
+
+	org := ruleOrganization(c, rule)
+	_, res, err := c.RulesUpdate(c.RequestContext(ctx), org, rule.Project.ValueString(), ruleType, rule.RuleId.ValueString(), req)
+
+	if err != nil {
+		diags.Append(apiErrorDiagnostics("Failed to update rule", res, err, ruleAPIFields(fields))...)
+	}
+
+	return
+}
+
+// callRuleDeleteAPI deletes a rule created with callRuleCreateAPI, a rule
+// that is already gone is not an error.
+func callRuleDeleteAPI(ctx context.Context, c *client.Client, ruleType string, rule *ruleBaseModel) (diags diag.Diagnostics) {
+	if rule.RuleId.IsNull() || rule.RuleId.IsUnknown() {
+		diags.AddAttributeError(
+			path.Root("uuid"),
+			"Missing rule.uuid attribute",
+			"Unable to delete unknown rule, please update terraform state.",
+		)
+		return
+	}
+
+	org := ruleOrganization(c, rule)
+	res, err := c.RulesDelete(c.RequestContext(ctx), org, rule.Project.ValueString(), ruleType, rule.RuleId.ValueString())
+
+	if err != nil && !utils.IsNotFound(res) {
+		diags.Append(apiErrorDiagnostics("Failed to delete rule", res, err, nil)...)
+	}
+
+	return
+}
+
+// ruleType describes a rule type built on the shared selection criteria. M
+// is the resource model embedding ruleBaseModel and A the action_config the
+// API returns for the type.
+type ruleType[M any, A any] struct {
+	// name is the rule type in the API path and the resource type name,
+	// eg. "auth" for quant_rule_auth.
+	name string
+
+	// attributes returns the action specific attributes.
+	attributes func(ctx context.Context) map[string]schema.Attribute
+
+	// toAction builds the action fields sent with the criteria.
+	toAction func(ctx context.Context, rule *M, diags *diag.Diagnostics) map[string]interface{}
+
+	// fields maps the action fields to their attributes.
+	fields apiFields
+
+	// fromAction copies a decoded action_config into the model.
+	fromAction func(ctx context.Context, rule *M, action A, diags *diag.Diagnostics)
+}
+
+// ruleModel gives the generic rule resource access to the criteria of a
+// resource model.
+type ruleModel[M any] interface {
+	*M
+	criteria() *ruleBaseModel
+}
+
+func (m *ruleBaseModel) criteria() *ruleBaseModel {
+	return m
+}
+
+// ruleResource implements the resource for a rule type that quant-admin-go
+// does not model, using the rules client. Each rule type declares an alias
+// of its instantiation, eg. ruleAuthResource.
+type ruleResource[M any, A any, P ruleModel[M]] struct {
+	ruleType[M, A]
+	client *client.Client
+}
+
+func (r *ruleResource[M, A, P]) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_rule_" + r.name
+}
+
+func (r *ruleResource[M, A, P]) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := RuleBaseAttributes(ctx)
+	for name, attribute := range r.attributes(ctx) {
+		attributes[name] = attribute
+	}
+
+	// Set the attributes for this rule.
+	resp.Schema = schema.Schema{Attributes: attributes, Blocks: timeoutsBlocks(ctx)}
+}
+
+func (r *ruleResource[M, A, P]) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyPlanProject(ctx, r.client, req, resp)
+}
+
+func (r *ruleResource[M, A, P]) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return RuleBaseConfigValidator()
+}
+
+func (r *ruleResource[M, A, P]) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected resource configure type",
+			fmt.Sprintf("Expected *internal.Client, got: %T. Please report this issue to the provider developers", req.ProviderData),
+		)
+	}
+	r.client = client
+}
+
+func (r *ruleResource[M, A, P]) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data M
+	rule := P(&data).criteria()
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := operationContext(ctx, rule.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
+	action := r.toAction(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create API call logic
+	resp.Diagnostics.Append(callRuleCreateAPI(ctx, r.client, r.name, rule, action, r.fields)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Read the API defaults and normalised values back into the model.
+	resp.Diagnostics.Append(r.read(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if rule.RuleId.IsNull() {
+		resp.Diagnostics.AddError(
+			"Failed to read rule",
+			fmt.Sprintf("The %s rule was not found after it was created.", r.name),
+		)
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ruleResource[M, A, P]) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data M
+	rule := P(&data).criteria()
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := operationContext(ctx, rule.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
+	// Read API call logic
+	resp.Diagnostics.Append(r.read(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The rule was removed outside of Terraform.
+	if rule.RuleId.IsNull() {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ruleResource[M, A, P]) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan M
+	rule := P(&plan).criteria()
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := operationContext(ctx, rule.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
+	var state M
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	rule.RuleId = P(&state).criteria().RuleId
+
+	action := r.toAction(ctx, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Update API call logic
+	resp.Diagnostics.Append(callRuleUpdateAPI(ctx, r.client, r.name, rule, action, r.fields)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.read(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if rule.RuleId.IsNull() {
+		resp.Diagnostics.AddError(
+			"Failed to read rule",
+			fmt.Sprintf("The %s rule was removed while it was being updated.", r.name),
+		)
+		return
+	}
+
+	// Save updated plan into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ruleResource[M, A, P]) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data M
+	rule := P(&data).criteria()
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := operationContext(ctx, rule.Timeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+
+	// Delete API call logic
+	resp.Diagnostics.Append(callRuleDeleteAPI(ctx, r.client, r.name, rule)...)
+}
+
+func (r *ruleResource[M, A, P]) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	var data M
+	rule := P(&data).criteria()
+
+	var err error
+	rule.Project, rule.RuleId, err = utils.GetRuleImportId(req.ID)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			err.Error(),
+		)
+		return
+	}
+
+	// Read API call logic
+	resp.Diagnostics.Append(r.read(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if rule.RuleId.IsNull() {
+		resp.Diagnostics.AddError(
+			"Unable to import rule",
+			fmt.Sprintf("No rule %s was found in the project %s.", req.ID, rule.Project.ValueString()),
+		)
+		return
+	}
+
+	// An imported resource has no timeouts block.
+	resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("timeouts"), &rule.Timeouts)...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// read loads the rule into data, RuleId is set to null when the rule no
+// longer exists.
+func (r *ruleResource[M, A, P]) read(ctx context.Context, data *M) (diags diag.Diagnostics) {
+	var action A
+	rule := P(data).criteria()
+
+	diags.Append(callRuleReadAPI(ctx, r.client, r.name, rule, &action)...)
+	if diags.HasError() || rule.RuleId.IsNull() {
+		return
+	}
+
+	r.fromAction(ctx, data, action, &diags)
+
+	return
+}
+
+// ruleCriteria resolves a selector and the is / is not lists to send.
+func ruleCriteria(ctx context.Context, filter string, selector types.String, is types.List, isNot types.List, diags *diag.Diagnostics) (string, []string, []string) {
+	value := *utils.GetRuleAny()
+	if !selector.IsNull() && !selector.IsUnknown() && selector.ValueString() != "" {
+		value = selector.ValueString()
+	}
+
+	isList := []string{}
+	isNotList := []string{}
+
+	switch value {
+	case *utils.GetFilterIs(filter):
+		isList = ruleListElements(ctx, is, diags)
+	case *utils.GetFilterIsNot(filter):
+		isNotList = ruleListElements(ctx, isNot, diags)
+	}
+
+	return value, isList, isNotList
+}
+
+func ruleListElements(ctx context.Context, list types.List, diags *diag.Diagnostics) []string {
+	values := []string{}
+	if list.IsNull() || list.IsUnknown() {
+		return values
+	}
+	diags.Append(list.ElementsAs(ctx, &values, false)...)
+	return values
+}
+
+func ruleListValue(ctx context.Context, prior types.List, values []string, diags *diag.Diagnostics) types.List {
+	if len(values) == 0 && (prior.IsNull() || prior.IsUnknown()) {
+		return types.ListNull(types.StringType)
+	}
+
+	if values == nil {
+		values = []string{}
+	}
+
+	list, d := types.ListValueFrom(ctx, types.StringType, values)
+	diags.Append(d...)
+	return list
+}
+
+func ruleSelectorValue(prior types.String, value string) types.String {
+	if value == "" || (value == *utils.GetRuleAny() && prior.IsNull()) {
+		if prior.IsUnknown() {
+			return types.StringNull()
+		}
+		return prior
+	}
+	return types.StringValue(value)
+}
+
+// ruleCriteriaValidator ensures the list for the chosen selector is set, eg.
+// country_is must be given when country is "country_is".
+type ruleCriteriaValidator struct {
+	filter string
+}
+
+func (v ruleCriteriaValidator) Description(ctx context.Context) string {
+	return fmt.Sprintf("%s_is or %s_is_not must be set to match the %s selector", v.filter, v.filter, v.filter)
+}
+
+func (v ruleCriteriaValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v ruleCriteriaValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var selector types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(v.filter), &selector)...)
+	if resp.Diagnostics.HasError() || selector.IsUnknown() {
+		return
+	}
+
+	for _, name := range []string{*utils.GetFilterIs(v.filter), *utils.GetFilterIsNot(v.filter)} {
+		var list types.List
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(name), &list)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		selected := selector.ValueString() == name
+		if selected && list.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(name),
+				"Missing rule criteria",
+				fmt.Sprintf("%s must be set when %s is %q.", name, v.filter, name),
+			)
+		}
+		if !selected && !list.IsNull() {
+			resp.Diagnostics.AddAttributeWarning(
+				path.Root(name),
+				"Unused rule criteria",
+				fmt.Sprintf("%s is ignored unless %s is %q.", name, v.filter, name),
+			)
+		}
+	}
+}
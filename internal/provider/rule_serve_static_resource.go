@@ -0,0 +1,69 @@
+package provider
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                     = (*ruleServeStaticResource)(nil)
+	_ resource.ResourceWithConfigure        = (*ruleServeStaticResource)(nil)
+	_ resource.ResourceWithImportState      = (*ruleServeStaticResource)(nil)
+	_ resource.ResourceWithConfigValidators = (*ruleServeStaticResource)(nil)
+	_ resource.ResourceWithModifyPlan       = (*ruleServeStaticResource)(nil)
+)
+
+func NewRuleServeStaticResource() resource.Resource {
+	return &ruleServeStaticResource{
+		ruleType: ruleType[ruleServeStaticResourceModel, ruleServeStaticAction]{
+			name:       "serve_static",
+			attributes: ruleServeStaticAttributes,
+			toAction:   ruleServeStaticActionValues,
+			fields:     apiFields{"static_file_path": "static_file_path"},
+			fromAction: setRuleServeStaticModel,
+		},
+	}
+}
+
+type ruleServeStaticResource = ruleResource[ruleServeStaticResourceModel, ruleServeStaticAction, *ruleServeStaticResourceModel]
+
+type ruleServeStaticResourceModel struct {
+	ruleBaseModel
+
+	// Rule specific details.
+	StaticFilePath types.String `tfsdk:"static_file_path"`
+}
+
+type ruleServeStaticAction struct {
+	StaticFilePath string `json:"static_file_path"`
+}
+
+func ruleServeStaticAttributes(ctx context.Context) map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"static_file_path": schema.StringAttribute{
+			MarkdownDescription: "Path of the static file served for matched requests, eg. /maintenance.html",
+			Required:            true,
+			Validators: []validator.String{
+				stringvalidator.RegexMatches(regexp.MustCompile(`^/\S*$`), "Must be an absolute path"),
+			},
+		},
+	}
+}
+
+func ruleServeStaticActionValues(ctx context.Context, rule *ruleServeStaticResourceModel, diags *diag.Diagnostics) map[string]interface{} {
+	return map[string]interface{}{
+		"static_file_path": rule.StaticFilePath.ValueString(),
+	}
+}
+
+// setRuleServeStaticModel reads a serve static rule.
+func setRuleServeStaticModel(ctx context.Context, rule *ruleServeStaticResourceModel, action ruleServeStaticAction, diags *diag.Diagnostics) {
+	rule.StaticFilePath = types.StringValue(action.StaticFilePath)
+}
@@ -0,0 +1,39 @@
+package provider_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccRuleServeStaticResource(t *testing.T) {
+	api := newFakeAPI(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDestroyed(api, "rules/serve_static"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(api) + `
+resource "quant_rule_serve_static" "test" {
+  project          = "api-test"
+  name             = "maintenance"
+  url              = ["/*"]
+  static_file_path = "/maintenance.html"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("quant_rule_serve_static.test", "uuid"),
+					resource.TestCheckResourceAttr("quant_rule_serve_static.test", "static_file_path", "/maintenance.html"),
+				),
+			},
+			{
+				ResourceName:                         "quant_rule_serve_static.test",
+				ImportState:                          true,
+				ImportStateIdFunc:                    testAccRuleImportId("quant_rule_serve_static.test"),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "uuid",
+			},
+		},
+	})
+}
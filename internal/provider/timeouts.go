@@ -0,0 +1,44 @@
+package provider
+
+import (
+	"context"
+	"terraform-provider-quant/internal/client"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// defaultTimeout bounds an operation when the timeouts block does not set
+// a timeout for it.
+const defaultTimeout = 20 * time.Minute
+
+// timeoutsBlocks returns the timeouts block supported by every resource, eg.
+//
+//	timeouts {
+//	  create = "5m"
+//	  delete = "10m"
+//	}
+func timeoutsBlocks(ctx context.Context) map[string]schema.Block {
+	return map[string]schema.Block{
+		"timeouts": timeouts.BlockAll(ctx),
+	}
+}
+
+// operationContext bounds ctx by the timeout of an operation, timeout is the
+// Create, Read, Update or Delete method of the resource's timeouts value.
+// API requests made with the returned context are aborted once it expires or
+// Terraform is interrupted. Each operation is given its own request ID, sent
+// with every API request it makes and added to its log entries.
+func operationContext(ctx context.Context, timeout func(context.Context, time.Duration) (time.Duration, diag.Diagnostics), diags *diag.Diagnostics) (context.Context, context.CancelFunc) {
+	d, ds := timeout(ctx, defaultTimeout)
+	diags.Append(ds...)
+
+	id := client.NewRequestID()
+	ctx = client.WithRequestID(ctx, id)
+	ctx = tflog.SetField(ctx, "request_id", id)
+
+	return context.WithTimeout(ctx, d)
+}
@@ -0,0 +1,118 @@
+package utils
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+type cronField struct {
+	name  string
+	min   int
+	max   int
+	names []string
+}
+
+var cronFields = []cronField{
+	{name: "minute", min: 0, max: 59},
+	{name: "hour", min: 0, max: 23},
+	{name: "day of month", min: 1, max: 31},
+	{name: "month", min: 1, max: 12, names: []string{"JAN", "FEB", "MAR", "APR", "MAY", "JUN", "JUL", "AUG", "SEP", "OCT", "NOV", "DEC"}},
+	{name: "day of week", min: 0, max: 7, names: []string{"SUN", "MON", "TUE", "WED", "THU", "FRI", "SAT"}},
+}
+
+// cronMacros maps the supported shorthands to their five field expression.
+var cronMacros = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+// ValidateCron checks a standard five field cron expression, eg. "0 3 * * MON-FRI".
+func ValidateCron(expr string) error {
+	expr = strings.TrimSpace(expr)
+	if _, ok := cronMacros[strings.ToLower(expr)]; ok {
+		return nil
+	}
+
+	parts := strings.Fields(expr)
+	if len(parts) != len(cronFields) {
+		return fmt.Errorf("expected %d fields (minute hour day-of-month month day-of-week), got %d", len(cronFields), len(parts))
+	}
+
+	for i, part := range parts {
+		if err := validateCronField(part, cronFields[i]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// NormalizeCron returns expr with macros expanded, names upper cased and
+// fields separated by a single space, so that expressions the API echoes
+// back differently can be compared.
+func NormalizeCron(expr string) string {
+	expr = strings.TrimSpace(expr)
+	if macro, ok := cronMacros[strings.ToLower(expr)]; ok {
+		return macro
+	}
+	return strings.ToUpper(strings.Join(strings.Fields(expr), " "))
+}
+
+func validateCronField(value string, field cronField) error {
+	for _, item := range strings.Split(value, ",") {
+		rng, step, hasStep := strings.Cut(item, "/")
+		if hasStep {
+			n, err := strconv.Atoi(step)
+			if err != nil || n < 1 {
+				return fmt.Errorf("invalid step %q in %s field", step, field.name)
+			}
+		}
+
+		if rng == "*" {
+			continue
+		}
+
+		start, end, isRange := strings.Cut(rng, "-")
+		from, err := parseCronValue(start, field)
+		if err != nil {
+			return err
+		}
+		if !isRange {
+			continue
+		}
+
+		to, err := parseCronValue(end, field)
+		if err != nil {
+			return err
+		}
+		if from > to {
+			return fmt.Errorf("invalid range %q in %s field", rng, field.name)
+		}
+	}
+
+	return nil
+}
+
+func parseCronValue(value string, field cronField) (int, error) {
+	for i, name := range field.names {
+		if strings.EqualFold(value, name) {
+			return i + field.min, nil
+		}
+	}
+
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid value %q in %s field", value, field.name)
+	}
+	if n < field.min || n > field.max {
+		return 0, fmt.Errorf("value %d out of range %d-%d in %s field", n, field.min, field.max, field.name)
+	}
+
+	return n, nil
+}
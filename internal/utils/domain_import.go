@@ -0,0 +1,24 @@
+package utils
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// GetDomainImportId splits a domain import ID into the project and either
+// the numeric domain ID or the hostname.
+func GetDomainImportId(s string) (types.String, types.Int64, types.String, error) {
+	parts := strings.Split(s, "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return types.StringNull(), types.Int64Null(), types.StringNull(), errors.New("The ID must follow the pattern project/domain_id or project/hostname to import")
+	}
+
+	if id, err := strconv.ParseInt(parts[1], 10, 64); err == nil {
+		return types.StringValue(parts[0]), types.Int64Value(id), types.StringNull(), nil
+	}
+
+	return types.StringValue(parts[0]), types.Int64Null(), types.StringValue(parts[1]), nil
+}
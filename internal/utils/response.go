@@ -0,0 +1,11 @@
+package utils
+
+import (
+	"net/http"
+)
+
+// IsNotFound reports whether the API responded that the requested object
+// does not exist.
+func IsNotFound(res *http.Response) bool {
+	return res != nil && res.StatusCode == http.StatusNotFound
+}
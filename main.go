@@ -9,12 +9,15 @@ import (
     "github.com/hashicorp/terraform-plugin-framework/providerserver"
 )
 
+// version is set by goreleaser at release time.
+var version string = "dev"
+
 func main() {
     opts := providerserver.ServeOpts{
         Address: "registry.terraform.io/quantcdn/quant",
     }
 
-    err := providerserver.Serve(context.Background(), provider.New(), opts)
+    err := providerserver.Serve(context.Background(), provider.New(version), opts)
     if err != nil {
         log.Fatal(err.Error())
     }